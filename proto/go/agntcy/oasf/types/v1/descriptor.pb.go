@@ -0,0 +1,196 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: agntcy/oasf/types/v1/descriptor.proto
+
+package typesv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Descriptor contains OCI-like metadata and optional inline payload
+// for a module artifact.
+type Descriptor struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Media type of the descriptor payload.
+	// Specs: https://www.rfc-editor.org/rfc/rfc6838
+	MediaType string `protobuf:"bytes,1,opt,name=media_type,json=mediaType,proto3" json:"media_type,omitempty"`
+	// Optional media type describing the artifact kind.
+	// Specs: https://www.rfc-editor.org/rfc/rfc6838
+	ArtifactType string `protobuf:"bytes,2,opt,name=artifact_type,json=artifactType,proto3" json:"artifact_type,omitempty"`
+	// Size of the decoded payload in bytes.
+	Size uint64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	// Digest of the decoded payload.
+	Digest string `protobuf:"bytes,4,opt,name=digest,proto3" json:"digest,omitempty"`
+	// Optional locations where the payload can be retrieved from.
+	Urls []string `protobuf:"bytes,5,rep,name=urls,proto3" json:"urls,omitempty"`
+	// Optional inline payload encoded as raw bytes.
+	// This field is represented as base64 in JSON.
+	Data []byte `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`
+	// Optional inline JSON payload for local tooling.
+	Json          *structpb.Struct `protobuf:"bytes,7,opt,name=json,proto3" json:"json,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Descriptor) Reset() {
+	*x = Descriptor{}
+	mi := &file_agntcy_oasf_types_v1_descriptor_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Descriptor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Descriptor) ProtoMessage() {}
+
+func (x *Descriptor) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_oasf_types_v1_descriptor_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Descriptor.ProtoReflect.Descriptor instead.
+func (*Descriptor) Descriptor() ([]byte, []int) {
+	return file_agntcy_oasf_types_v1_descriptor_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Descriptor) GetMediaType() string {
+	if x != nil {
+		return x.MediaType
+	}
+	return ""
+}
+
+func (x *Descriptor) GetArtifactType() string {
+	if x != nil {
+		return x.ArtifactType
+	}
+	return ""
+}
+
+func (x *Descriptor) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Descriptor) GetDigest() string {
+	if x != nil {
+		return x.Digest
+	}
+	return ""
+}
+
+func (x *Descriptor) GetUrls() []string {
+	if x != nil {
+		return x.Urls
+	}
+	return nil
+}
+
+func (x *Descriptor) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *Descriptor) GetJson() *structpb.Struct {
+	if x != nil {
+		return x.Json
+	}
+	return nil
+}
+
+var File_agntcy_oasf_types_v1_descriptor_proto protoreflect.FileDescriptor
+
+const file_agntcy_oasf_types_v1_descriptor_proto_rawDesc = "" +
+	"\n" +
+	"%agntcy/oasf/types/v1/descriptor.proto\x12\x14agntcy.oasf.types.v1\x1a\x1cgoogle/protobuf/struct.proto\"\xd1\x01\n" +
+	"\n" +
+	"Descriptor\x12\x1d\n" +
+	"\n" +
+	"media_type\x18\x01 \x01(\tR\tmediaType\x12#\n" +
+	"\rartifact_type\x18\x02 \x01(\tR\fartifactType\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x04R\x04size\x12\x16\n" +
+	"\x06digest\x18\x04 \x01(\tR\x06digest\x12\x12\n" +
+	"\x04urls\x18\x05 \x03(\tR\x04urls\x12\x12\n" +
+	"\x04data\x18\x06 \x01(\fR\x04data\x12+\n" +
+	"\x04json\x18\a \x01(\v2\x17.google.protobuf.StructR\x04jsonb\x06proto3"
+
+var (
+	file_agntcy_oasf_types_v1_descriptor_proto_rawDescOnce sync.Once
+	file_agntcy_oasf_types_v1_descriptor_proto_rawDescData []byte
+)
+
+func file_agntcy_oasf_types_v1_descriptor_proto_rawDescGZIP() []byte {
+	file_agntcy_oasf_types_v1_descriptor_proto_rawDescOnce.Do(func() {
+		file_agntcy_oasf_types_v1_descriptor_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_agntcy_oasf_types_v1_descriptor_proto_rawDesc), len(file_agntcy_oasf_types_v1_descriptor_proto_rawDesc)))
+	})
+	return file_agntcy_oasf_types_v1_descriptor_proto_rawDescData
+}
+
+var file_agntcy_oasf_types_v1_descriptor_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_agntcy_oasf_types_v1_descriptor_proto_goTypes = []any{
+	(*Descriptor)(nil),      // 0: agntcy.oasf.types.v1.Descriptor
+	(*structpb.Struct)(nil), // 1: google.protobuf.Struct
+}
+var file_agntcy_oasf_types_v1_descriptor_proto_depIdxs = []int32{
+	1, // 0: agntcy.oasf.types.v1.Descriptor.json:type_name -> google.protobuf.Struct
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_agntcy_oasf_types_v1_descriptor_proto_init() }
+func file_agntcy_oasf_types_v1_descriptor_proto_init() {
+	if File_agntcy_oasf_types_v1_descriptor_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_agntcy_oasf_types_v1_descriptor_proto_rawDesc), len(file_agntcy_oasf_types_v1_descriptor_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_agntcy_oasf_types_v1_descriptor_proto_goTypes,
+		DependencyIndexes: file_agntcy_oasf_types_v1_descriptor_proto_depIdxs,
+		MessageInfos:      file_agntcy_oasf_types_v1_descriptor_proto_msgTypes,
+	}.Build()
+	File_agntcy_oasf_types_v1_descriptor_proto = out.File
+	file_agntcy_oasf_types_v1_descriptor_proto_goTypes = nil
+	file_agntcy_oasf_types_v1_descriptor_proto_depIdxs = nil
+}
@@ -0,0 +1,153 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: agntcy/oasf/types/v1/domain.proto
+
+package typesv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// A specific domain under which the record can operate in.
+type Domain struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Metadata associated with the domain.
+	// Annotations with "agntcy." prefix are reserved for system use.
+	Annotations map[string]string `protobuf:"bytes,1,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Unique name of the domain.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Unique identifier of the domain.
+	Id            uint32 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Domain) Reset() {
+	*x = Domain{}
+	mi := &file_agntcy_oasf_types_v1_domain_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Domain) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Domain) ProtoMessage() {}
+
+func (x *Domain) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_oasf_types_v1_domain_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Domain.ProtoReflect.Descriptor instead.
+func (*Domain) Descriptor() ([]byte, []int) {
+	return file_agntcy_oasf_types_v1_domain_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Domain) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *Domain) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Domain) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+var File_agntcy_oasf_types_v1_domain_proto protoreflect.FileDescriptor
+
+const file_agntcy_oasf_types_v1_domain_proto_rawDesc = "" +
+	"\n" +
+	"!agntcy/oasf/types/v1/domain.proto\x12\x14agntcy.oasf.types.v1\"\xbd\x01\n" +
+	"\x06Domain\x12O\n" +
+	"\vannotations\x18\x01 \x03(\v2-.agntcy.oasf.types.v1.Domain.AnnotationsEntryR\vannotations\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x0e\n" +
+	"\x02id\x18\x03 \x01(\rR\x02id\x1a>\n" +
+	"\x10AnnotationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01b\x06proto3"
+
+var (
+	file_agntcy_oasf_types_v1_domain_proto_rawDescOnce sync.Once
+	file_agntcy_oasf_types_v1_domain_proto_rawDescData []byte
+)
+
+func file_agntcy_oasf_types_v1_domain_proto_rawDescGZIP() []byte {
+	file_agntcy_oasf_types_v1_domain_proto_rawDescOnce.Do(func() {
+		file_agntcy_oasf_types_v1_domain_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_agntcy_oasf_types_v1_domain_proto_rawDesc), len(file_agntcy_oasf_types_v1_domain_proto_rawDesc)))
+	})
+	return file_agntcy_oasf_types_v1_domain_proto_rawDescData
+}
+
+var file_agntcy_oasf_types_v1_domain_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_agntcy_oasf_types_v1_domain_proto_goTypes = []any{
+	(*Domain)(nil), // 0: agntcy.oasf.types.v1.Domain
+	nil,            // 1: agntcy.oasf.types.v1.Domain.AnnotationsEntry
+}
+var file_agntcy_oasf_types_v1_domain_proto_depIdxs = []int32{
+	1, // 0: agntcy.oasf.types.v1.Domain.annotations:type_name -> agntcy.oasf.types.v1.Domain.AnnotationsEntry
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_agntcy_oasf_types_v1_domain_proto_init() }
+func file_agntcy_oasf_types_v1_domain_proto_init() {
+	if File_agntcy_oasf_types_v1_domain_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_agntcy_oasf_types_v1_domain_proto_rawDesc), len(file_agntcy_oasf_types_v1_domain_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_agntcy_oasf_types_v1_domain_proto_goTypes,
+		DependencyIndexes: file_agntcy_oasf_types_v1_domain_proto_depIdxs,
+		MessageInfos:      file_agntcy_oasf_types_v1_domain_proto_msgTypes,
+	}.Build()
+	File_agntcy_oasf_types_v1_domain_proto = out.File
+	file_agntcy_oasf_types_v1_domain_proto_goTypes = nil
+	file_agntcy_oasf_types_v1_domain_proto_depIdxs = nil
+}
@@ -0,0 +1,233 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: agntcy/oasf/types/v1/locator.proto
+
+package typesv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// LocatorType defines placeholders for supported locators.
+// Used in lowercase string format across APIs.
+type LocatorType int32
+
+const (
+	LocatorType_UNSPECIFIED     LocatorType = 0 // ""
+	LocatorType_HELM_CHART      LocatorType = 1 // "helm_chart"
+	LocatorType_CONTAINER_IMAGE LocatorType = 2 // "container_image"
+	LocatorType_PACKAGE         LocatorType = 3 // "package"
+	LocatorType_SOURCE_CODE     LocatorType = 4 // "source_code"
+	LocatorType_BINARY          LocatorType = 5 // "binary"
+	LocatorType_URL             LocatorType = 6 // "url"
+)
+
+// Enum value maps for LocatorType.
+var (
+	LocatorType_name = map[int32]string{
+		0: "UNSPECIFIED",
+		1: "HELM_CHART",
+		2: "CONTAINER_IMAGE",
+		3: "PACKAGE",
+		4: "SOURCE_CODE",
+		5: "BINARY",
+		6: "URL",
+	}
+	LocatorType_value = map[string]int32{
+		"UNSPECIFIED":     0,
+		"HELM_CHART":      1,
+		"CONTAINER_IMAGE": 2,
+		"PACKAGE":         3,
+		"SOURCE_CODE":     4,
+		"BINARY":          5,
+		"URL":             6,
+	}
+)
+
+func (x LocatorType) Enum() *LocatorType {
+	p := new(LocatorType)
+	*p = x
+	return p
+}
+
+func (x LocatorType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LocatorType) Descriptor() protoreflect.EnumDescriptor {
+	return file_agntcy_oasf_types_v1_locator_proto_enumTypes[0].Descriptor()
+}
+
+func (LocatorType) Type() protoreflect.EnumType {
+	return &file_agntcy_oasf_types_v1_locator_proto_enumTypes[0]
+}
+
+func (x LocatorType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LocatorType.Descriptor instead.
+func (LocatorType) EnumDescriptor() ([]byte, []int) {
+	return file_agntcy_oasf_types_v1_locator_proto_rawDescGZIP(), []int{0}
+}
+
+// Locator points to the source where record can be found at.
+// For example, a locator can be a link to a helm chart.
+type Locator struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Metadata associated with the locator.
+	// Annotations with "agntcy." prefix are reserved for system use.
+	Annotations map[string]string `protobuf:"bytes,1,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Type of the locator.
+	// Supports custom values.
+	// Native types are defined in the LocatorType enum.
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// Locations where the source can be found at.
+	// Specs: https://datatracker.ietf.org/doc/html/rfc1738
+	Urls          []string `protobuf:"bytes,3,rep,name=urls,proto3" json:"urls,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Locator) Reset() {
+	*x = Locator{}
+	mi := &file_agntcy_oasf_types_v1_locator_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Locator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Locator) ProtoMessage() {}
+
+func (x *Locator) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_oasf_types_v1_locator_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Locator.ProtoReflect.Descriptor instead.
+func (*Locator) Descriptor() ([]byte, []int) {
+	return file_agntcy_oasf_types_v1_locator_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Locator) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *Locator) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Locator) GetUrls() []string {
+	if x != nil {
+		return x.Urls
+	}
+	return nil
+}
+
+var File_agntcy_oasf_types_v1_locator_proto protoreflect.FileDescriptor
+
+const file_agntcy_oasf_types_v1_locator_proto_rawDesc = "" +
+	"\n" +
+	"\"agntcy/oasf/types/v1/locator.proto\x12\x14agntcy.oasf.types.v1\"\xc3\x01\n" +
+	"\aLocator\x12P\n" +
+	"\vannotations\x18\x01 \x03(\v2..agntcy.oasf.types.v1.Locator.AnnotationsEntryR\vannotations\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x12\n" +
+	"\x04urls\x18\x03 \x03(\tR\x04urls\x1a>\n" +
+	"\x10AnnotationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01*v\n" +
+	"\vLocatorType\x12\x0f\n" +
+	"\vUNSPECIFIED\x10\x00\x12\x0e\n" +
+	"\n" +
+	"HELM_CHART\x10\x01\x12\x13\n" +
+	"\x0fCONTAINER_IMAGE\x10\x02\x12\v\n" +
+	"\aPACKAGE\x10\x03\x12\x0f\n" +
+	"\vSOURCE_CODE\x10\x04\x12\n" +
+	"\n" +
+	"\x06BINARY\x10\x05\x12\a\n" +
+	"\x03URL\x10\x06b\x06proto3"
+
+var (
+	file_agntcy_oasf_types_v1_locator_proto_rawDescOnce sync.Once
+	file_agntcy_oasf_types_v1_locator_proto_rawDescData []byte
+)
+
+func file_agntcy_oasf_types_v1_locator_proto_rawDescGZIP() []byte {
+	file_agntcy_oasf_types_v1_locator_proto_rawDescOnce.Do(func() {
+		file_agntcy_oasf_types_v1_locator_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_agntcy_oasf_types_v1_locator_proto_rawDesc), len(file_agntcy_oasf_types_v1_locator_proto_rawDesc)))
+	})
+	return file_agntcy_oasf_types_v1_locator_proto_rawDescData
+}
+
+var file_agntcy_oasf_types_v1_locator_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_agntcy_oasf_types_v1_locator_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_agntcy_oasf_types_v1_locator_proto_goTypes = []any{
+	(LocatorType)(0), // 0: agntcy.oasf.types.v1.LocatorType
+	(*Locator)(nil),  // 1: agntcy.oasf.types.v1.Locator
+	nil,              // 2: agntcy.oasf.types.v1.Locator.AnnotationsEntry
+}
+var file_agntcy_oasf_types_v1_locator_proto_depIdxs = []int32{
+	2, // 0: agntcy.oasf.types.v1.Locator.annotations:type_name -> agntcy.oasf.types.v1.Locator.AnnotationsEntry
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_agntcy_oasf_types_v1_locator_proto_init() }
+func file_agntcy_oasf_types_v1_locator_proto_init() {
+	if File_agntcy_oasf_types_v1_locator_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_agntcy_oasf_types_v1_locator_proto_rawDesc), len(file_agntcy_oasf_types_v1_locator_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_agntcy_oasf_types_v1_locator_proto_goTypes,
+		DependencyIndexes: file_agntcy_oasf_types_v1_locator_proto_depIdxs,
+		EnumInfos:         file_agntcy_oasf_types_v1_locator_proto_enumTypes,
+		MessageInfos:      file_agntcy_oasf_types_v1_locator_proto_msgTypes,
+	}.Build()
+	File_agntcy_oasf_types_v1_locator_proto = out.File
+	file_agntcy_oasf_types_v1_locator_proto_goTypes = nil
+	file_agntcy_oasf_types_v1_locator_proto_depIdxs = nil
+}
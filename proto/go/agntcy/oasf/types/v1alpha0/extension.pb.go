@@ -0,0 +1,169 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: agntcy/oasf/types/v1alpha0/extension.proto
+
+package typesv1alpha0
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Extensions provide dynamic descriptors for a record data model.
+// For example, arbitrary data and third-party features can be
+// described using extensions.
+type Extension struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of the extension attached to a record.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Version of the extension attached to a record.
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Metadata associated with this extension.
+	Annotations map[string]string `protobuf:"bytes,3,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Value of the data.
+	Data          *structpb.Struct `protobuf:"bytes,4,opt,name=data,proto3,oneof" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Extension) Reset() {
+	*x = Extension{}
+	mi := &file_agntcy_oasf_types_v1alpha0_extension_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Extension) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Extension) ProtoMessage() {}
+
+func (x *Extension) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_oasf_types_v1alpha0_extension_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Extension.ProtoReflect.Descriptor instead.
+func (*Extension) Descriptor() ([]byte, []int) {
+	return file_agntcy_oasf_types_v1alpha0_extension_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Extension) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Extension) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *Extension) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *Extension) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+var File_agntcy_oasf_types_v1alpha0_extension_proto protoreflect.FileDescriptor
+
+const file_agntcy_oasf_types_v1alpha0_extension_proto_rawDesc = "" +
+	"\n" +
+	"*agntcy/oasf/types/v1alpha0/extension.proto\x12\x1aagntcy.oasf.types.v1alpha0\x1a\x1cgoogle/protobuf/struct.proto\"\x8e\x02\n" +
+	"\tExtension\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\x12X\n" +
+	"\vannotations\x18\x03 \x03(\v26.agntcy.oasf.types.v1alpha0.Extension.AnnotationsEntryR\vannotations\x120\n" +
+	"\x04data\x18\x04 \x01(\v2\x17.google.protobuf.StructH\x00R\x04data\x88\x01\x01\x1a>\n" +
+	"\x10AnnotationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\a\n" +
+	"\x05_datab\x06proto3"
+
+var (
+	file_agntcy_oasf_types_v1alpha0_extension_proto_rawDescOnce sync.Once
+	file_agntcy_oasf_types_v1alpha0_extension_proto_rawDescData []byte
+)
+
+func file_agntcy_oasf_types_v1alpha0_extension_proto_rawDescGZIP() []byte {
+	file_agntcy_oasf_types_v1alpha0_extension_proto_rawDescOnce.Do(func() {
+		file_agntcy_oasf_types_v1alpha0_extension_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_agntcy_oasf_types_v1alpha0_extension_proto_rawDesc), len(file_agntcy_oasf_types_v1alpha0_extension_proto_rawDesc)))
+	})
+	return file_agntcy_oasf_types_v1alpha0_extension_proto_rawDescData
+}
+
+var file_agntcy_oasf_types_v1alpha0_extension_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_agntcy_oasf_types_v1alpha0_extension_proto_goTypes = []any{
+	(*Extension)(nil),       // 0: agntcy.oasf.types.v1alpha0.Extension
+	nil,                     // 1: agntcy.oasf.types.v1alpha0.Extension.AnnotationsEntry
+	(*structpb.Struct)(nil), // 2: google.protobuf.Struct
+}
+var file_agntcy_oasf_types_v1alpha0_extension_proto_depIdxs = []int32{
+	1, // 0: agntcy.oasf.types.v1alpha0.Extension.annotations:type_name -> agntcy.oasf.types.v1alpha0.Extension.AnnotationsEntry
+	2, // 1: agntcy.oasf.types.v1alpha0.Extension.data:type_name -> google.protobuf.Struct
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_agntcy_oasf_types_v1alpha0_extension_proto_init() }
+func file_agntcy_oasf_types_v1alpha0_extension_proto_init() {
+	if File_agntcy_oasf_types_v1alpha0_extension_proto != nil {
+		return
+	}
+	file_agntcy_oasf_types_v1alpha0_extension_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_agntcy_oasf_types_v1alpha0_extension_proto_rawDesc), len(file_agntcy_oasf_types_v1alpha0_extension_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_agntcy_oasf_types_v1alpha0_extension_proto_goTypes,
+		DependencyIndexes: file_agntcy_oasf_types_v1alpha0_extension_proto_depIdxs,
+		MessageInfos:      file_agntcy_oasf_types_v1alpha0_extension_proto_msgTypes,
+	}.Build()
+	File_agntcy_oasf_types_v1alpha0_extension_proto = out.File
+	file_agntcy_oasf_types_v1alpha0_extension_proto_goTypes = nil
+	file_agntcy_oasf_types_v1alpha0_extension_proto_depIdxs = nil
+}
@@ -0,0 +1,176 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: agntcy/oasf/types/v1alpha0/skill.proto
+
+package typesv1alpha0
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// A specific skills that a record is capable of performing.
+type Skill struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Additional metadata for this skill.
+	Annotations map[string]string `protobuf:"bytes,1,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// UID of the category.
+	CategoryUid uint64 `protobuf:"varint,2,opt,name=category_uid,json=categoryUid,proto3" json:"category_uid,omitempty"`
+	// UID of the class.
+	ClassUid uint64 `protobuf:"varint,3,opt,name=class_uid,json=classUid,proto3" json:"class_uid,omitempty"`
+	// Optional human-readable name of the category.
+	CategoryName *string `protobuf:"bytes,4,opt,name=category_name,json=categoryName,proto3,oneof" json:"category_name,omitempty"`
+	// Optional human-readable name of the class.
+	ClassName     *string `protobuf:"bytes,5,opt,name=class_name,json=className,proto3,oneof" json:"class_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Skill) Reset() {
+	*x = Skill{}
+	mi := &file_agntcy_oasf_types_v1alpha0_skill_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Skill) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Skill) ProtoMessage() {}
+
+func (x *Skill) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_oasf_types_v1alpha0_skill_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Skill.ProtoReflect.Descriptor instead.
+func (*Skill) Descriptor() ([]byte, []int) {
+	return file_agntcy_oasf_types_v1alpha0_skill_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Skill) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *Skill) GetCategoryUid() uint64 {
+	if x != nil {
+		return x.CategoryUid
+	}
+	return 0
+}
+
+func (x *Skill) GetClassUid() uint64 {
+	if x != nil {
+		return x.ClassUid
+	}
+	return 0
+}
+
+func (x *Skill) GetCategoryName() string {
+	if x != nil && x.CategoryName != nil {
+		return *x.CategoryName
+	}
+	return ""
+}
+
+func (x *Skill) GetClassName() string {
+	if x != nil && x.ClassName != nil {
+		return *x.ClassName
+	}
+	return ""
+}
+
+var File_agntcy_oasf_types_v1alpha0_skill_proto protoreflect.FileDescriptor
+
+const file_agntcy_oasf_types_v1alpha0_skill_proto_rawDesc = "" +
+	"\n" +
+	"&agntcy/oasf/types/v1alpha0/skill.proto\x12\x1aagntcy.oasf.types.v1alpha0\"\xcc\x02\n" +
+	"\x05Skill\x12T\n" +
+	"\vannotations\x18\x01 \x03(\v22.agntcy.oasf.types.v1alpha0.Skill.AnnotationsEntryR\vannotations\x12!\n" +
+	"\fcategory_uid\x18\x02 \x01(\x04R\vcategoryUid\x12\x1b\n" +
+	"\tclass_uid\x18\x03 \x01(\x04R\bclassUid\x12(\n" +
+	"\rcategory_name\x18\x04 \x01(\tH\x00R\fcategoryName\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"class_name\x18\x05 \x01(\tH\x01R\tclassName\x88\x01\x01\x1a>\n" +
+	"\x10AnnotationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x10\n" +
+	"\x0e_category_nameB\r\n" +
+	"\v_class_nameb\x06proto3"
+
+var (
+	file_agntcy_oasf_types_v1alpha0_skill_proto_rawDescOnce sync.Once
+	file_agntcy_oasf_types_v1alpha0_skill_proto_rawDescData []byte
+)
+
+func file_agntcy_oasf_types_v1alpha0_skill_proto_rawDescGZIP() []byte {
+	file_agntcy_oasf_types_v1alpha0_skill_proto_rawDescOnce.Do(func() {
+		file_agntcy_oasf_types_v1alpha0_skill_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_agntcy_oasf_types_v1alpha0_skill_proto_rawDesc), len(file_agntcy_oasf_types_v1alpha0_skill_proto_rawDesc)))
+	})
+	return file_agntcy_oasf_types_v1alpha0_skill_proto_rawDescData
+}
+
+var file_agntcy_oasf_types_v1alpha0_skill_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_agntcy_oasf_types_v1alpha0_skill_proto_goTypes = []any{
+	(*Skill)(nil), // 0: agntcy.oasf.types.v1alpha0.Skill
+	nil,           // 1: agntcy.oasf.types.v1alpha0.Skill.AnnotationsEntry
+}
+var file_agntcy_oasf_types_v1alpha0_skill_proto_depIdxs = []int32{
+	1, // 0: agntcy.oasf.types.v1alpha0.Skill.annotations:type_name -> agntcy.oasf.types.v1alpha0.Skill.AnnotationsEntry
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_agntcy_oasf_types_v1alpha0_skill_proto_init() }
+func file_agntcy_oasf_types_v1alpha0_skill_proto_init() {
+	if File_agntcy_oasf_types_v1alpha0_skill_proto != nil {
+		return
+	}
+	file_agntcy_oasf_types_v1alpha0_skill_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_agntcy_oasf_types_v1alpha0_skill_proto_rawDesc), len(file_agntcy_oasf_types_v1alpha0_skill_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_agntcy_oasf_types_v1alpha0_skill_proto_goTypes,
+		DependencyIndexes: file_agntcy_oasf_types_v1alpha0_skill_proto_depIdxs,
+		MessageInfos:      file_agntcy_oasf_types_v1alpha0_skill_proto_msgTypes,
+	}.Build()
+	File_agntcy_oasf_types_v1alpha0_skill_proto = out.File
+	file_agntcy_oasf_types_v1alpha0_skill_proto_goTypes = nil
+	file_agntcy_oasf_types_v1alpha0_skill_proto_depIdxs = nil
+}
@@ -0,0 +1,169 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: agntcy/oasf/types/v1alpha1/module.proto
+
+package typesv1alpha1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Modules provide a generic way to attach additional information
+// to the record. For example, application-specific
+// details can be provided using a module.
+type Module struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of the module.
+	// Can be used as a fully qualified name.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Unique identifier of the module.
+	Id uint32 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// Metadata associated with the module.
+	Annotations map[string]string `protobuf:"bytes,3,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Data attached to the module.
+	// Usually a JSON-embedded object.
+	Data          *structpb.Struct `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Module) Reset() {
+	*x = Module{}
+	mi := &file_agntcy_oasf_types_v1alpha1_module_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Module) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Module) ProtoMessage() {}
+
+func (x *Module) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_oasf_types_v1alpha1_module_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Module.ProtoReflect.Descriptor instead.
+func (*Module) Descriptor() ([]byte, []int) {
+	return file_agntcy_oasf_types_v1alpha1_module_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Module) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Module) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Module) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *Module) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+var File_agntcy_oasf_types_v1alpha1_module_proto protoreflect.FileDescriptor
+
+const file_agntcy_oasf_types_v1alpha1_module_proto_rawDesc = "" +
+	"\n" +
+	"'agntcy/oasf/types/v1alpha1/module.proto\x12\x1aagntcy.oasf.types.v1alpha1\x1a\x1cgoogle/protobuf/struct.proto\"\xf0\x01\n" +
+	"\x06Module\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\rR\x02id\x12U\n" +
+	"\vannotations\x18\x03 \x03(\v23.agntcy.oasf.types.v1alpha1.Module.AnnotationsEntryR\vannotations\x12+\n" +
+	"\x04data\x18\x04 \x01(\v2\x17.google.protobuf.StructR\x04data\x1a>\n" +
+	"\x10AnnotationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01b\x06proto3"
+
+var (
+	file_agntcy_oasf_types_v1alpha1_module_proto_rawDescOnce sync.Once
+	file_agntcy_oasf_types_v1alpha1_module_proto_rawDescData []byte
+)
+
+func file_agntcy_oasf_types_v1alpha1_module_proto_rawDescGZIP() []byte {
+	file_agntcy_oasf_types_v1alpha1_module_proto_rawDescOnce.Do(func() {
+		file_agntcy_oasf_types_v1alpha1_module_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_agntcy_oasf_types_v1alpha1_module_proto_rawDesc), len(file_agntcy_oasf_types_v1alpha1_module_proto_rawDesc)))
+	})
+	return file_agntcy_oasf_types_v1alpha1_module_proto_rawDescData
+}
+
+var file_agntcy_oasf_types_v1alpha1_module_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_agntcy_oasf_types_v1alpha1_module_proto_goTypes = []any{
+	(*Module)(nil),          // 0: agntcy.oasf.types.v1alpha1.Module
+	nil,                     // 1: agntcy.oasf.types.v1alpha1.Module.AnnotationsEntry
+	(*structpb.Struct)(nil), // 2: google.protobuf.Struct
+}
+var file_agntcy_oasf_types_v1alpha1_module_proto_depIdxs = []int32{
+	1, // 0: agntcy.oasf.types.v1alpha1.Module.annotations:type_name -> agntcy.oasf.types.v1alpha1.Module.AnnotationsEntry
+	2, // 1: agntcy.oasf.types.v1alpha1.Module.data:type_name -> google.protobuf.Struct
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_agntcy_oasf_types_v1alpha1_module_proto_init() }
+func file_agntcy_oasf_types_v1alpha1_module_proto_init() {
+	if File_agntcy_oasf_types_v1alpha1_module_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_agntcy_oasf_types_v1alpha1_module_proto_rawDesc), len(file_agntcy_oasf_types_v1alpha1_module_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_agntcy_oasf_types_v1alpha1_module_proto_goTypes,
+		DependencyIndexes: file_agntcy_oasf_types_v1alpha1_module_proto_depIdxs,
+		MessageInfos:      file_agntcy_oasf_types_v1alpha1_module_proto_msgTypes,
+	}.Build()
+	File_agntcy_oasf_types_v1alpha1_module_proto = out.File
+	file_agntcy_oasf_types_v1alpha1_module_proto_goTypes = nil
+	file_agntcy_oasf_types_v1alpha1_module_proto_depIdxs = nil
+}
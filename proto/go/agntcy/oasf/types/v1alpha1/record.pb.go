@@ -0,0 +1,287 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: agntcy/oasf/types/v1alpha1/record.proto
+
+package typesv1alpha1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Record defines a schema for versioned AI agentic content representation.
+// The schema provides a way to describe an agentic record in a structured format.
+//
+// This is a versioned gRPC-based schema of OASF Record object.
+//
+// Max size: 4 MB (or to fully fit in a single request)
+// It may be required to support larger record size in the future.
+//
+// Records are stored in a content-addressable store.
+// Records can be indexed for quick lookups and searches to avoid unnecessary data transfer.
+//
+// All records are referenced by a globally-unique content identifier (CID).
+// Specs: https://github.com/multiformats/cid
+type Record struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of the record.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Version of the record.
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Schema version of the record.
+	SchemaVersion string `protobuf:"bytes,3,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+	// Description of the record.
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// List of record authors, e.g. in the form of `author-name <author-email>`.
+	Authors []string `protobuf:"bytes,5,rep,name=authors,proto3" json:"authors,omitempty"`
+	// Metadata associated with the record.
+	Annotations map[string]string `protobuf:"bytes,6,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Creation timestamp of the record in the RFC3339 format.
+	// Specs: https://www.rfc-editor.org/rfc/rfc3339.html
+	CreatedAt string `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// List of source locators where the record can be found or used from.
+	Locators []*Locator `protobuf:"bytes,8,rep,name=locators,proto3" json:"locators,omitempty"`
+	// List of skills that the record can perform.
+	Skills []*Skill `protobuf:"bytes,9,rep,name=skills,proto3" json:"skills,omitempty"`
+	// List of domains under which the record can operate in.
+	Domains []*Domain `protobuf:"bytes,10,rep,name=domains,proto3" json:"domains,omitempty"`
+	// Additional information attached to the record.
+	// Modules are used to generically extend the record's functionality.
+	Modules []*Module `protobuf:"bytes,11,rep,name=modules,proto3" json:"modules,omitempty"`
+	// Security signature of the record.
+	Signature *Signature `protobuf:"bytes,12,opt,name=signature,proto3" json:"signature,omitempty"`
+	// Reference to the previous record, if any.
+	// Used to link the record to its previous versions.
+	// Field number is explicitly reserved for extendability.
+	PreviousRecordCid *string `protobuf:"bytes,99,opt,name=previous_record_cid,json=previousRecordCid,proto3,oneof" json:"previous_record_cid,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Record) Reset() {
+	*x = Record{}
+	mi := &file_agntcy_oasf_types_v1alpha1_record_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Record) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Record) ProtoMessage() {}
+
+func (x *Record) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_oasf_types_v1alpha1_record_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Record.ProtoReflect.Descriptor instead.
+func (*Record) Descriptor() ([]byte, []int) {
+	return file_agntcy_oasf_types_v1alpha1_record_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Record) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Record) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *Record) GetSchemaVersion() string {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return ""
+}
+
+func (x *Record) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Record) GetAuthors() []string {
+	if x != nil {
+		return x.Authors
+	}
+	return nil
+}
+
+func (x *Record) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *Record) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Record) GetLocators() []*Locator {
+	if x != nil {
+		return x.Locators
+	}
+	return nil
+}
+
+func (x *Record) GetSkills() []*Skill {
+	if x != nil {
+		return x.Skills
+	}
+	return nil
+}
+
+func (x *Record) GetDomains() []*Domain {
+	if x != nil {
+		return x.Domains
+	}
+	return nil
+}
+
+func (x *Record) GetModules() []*Module {
+	if x != nil {
+		return x.Modules
+	}
+	return nil
+}
+
+func (x *Record) GetSignature() *Signature {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *Record) GetPreviousRecordCid() string {
+	if x != nil && x.PreviousRecordCid != nil {
+		return *x.PreviousRecordCid
+	}
+	return ""
+}
+
+var File_agntcy_oasf_types_v1alpha1_record_proto protoreflect.FileDescriptor
+
+const file_agntcy_oasf_types_v1alpha1_record_proto_rawDesc = "" +
+	"\n" +
+	"'agntcy/oasf/types/v1alpha1/record.proto\x12\x1aagntcy.oasf.types.v1alpha1\x1a'agntcy/oasf/types/v1alpha1/domain.proto\x1a(agntcy/oasf/types/v1alpha1/locator.proto\x1a'agntcy/oasf/types/v1alpha1/module.proto\x1a*agntcy/oasf/types/v1alpha1/signature.proto\x1a&agntcy/oasf/types/v1alpha1/skill.proto\"\xd9\x05\n" +
+	"\x06Record\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\x12%\n" +
+	"\x0eschema_version\x18\x03 \x01(\tR\rschemaVersion\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x18\n" +
+	"\aauthors\x18\x05 \x03(\tR\aauthors\x12U\n" +
+	"\vannotations\x18\x06 \x03(\v23.agntcy.oasf.types.v1alpha1.Record.AnnotationsEntryR\vannotations\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\x12?\n" +
+	"\blocators\x18\b \x03(\v2#.agntcy.oasf.types.v1alpha1.LocatorR\blocators\x129\n" +
+	"\x06skills\x18\t \x03(\v2!.agntcy.oasf.types.v1alpha1.SkillR\x06skills\x12<\n" +
+	"\adomains\x18\n" +
+	" \x03(\v2\".agntcy.oasf.types.v1alpha1.DomainR\adomains\x12<\n" +
+	"\amodules\x18\v \x03(\v2\".agntcy.oasf.types.v1alpha1.ModuleR\amodules\x12C\n" +
+	"\tsignature\x18\f \x01(\v2%.agntcy.oasf.types.v1alpha1.SignatureR\tsignature\x123\n" +
+	"\x13previous_record_cid\x18c \x01(\tH\x00R\x11previousRecordCid\x88\x01\x01\x1a>\n" +
+	"\x10AnnotationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x16\n" +
+	"\x14_previous_record_cidb\x06proto3"
+
+var (
+	file_agntcy_oasf_types_v1alpha1_record_proto_rawDescOnce sync.Once
+	file_agntcy_oasf_types_v1alpha1_record_proto_rawDescData []byte
+)
+
+func file_agntcy_oasf_types_v1alpha1_record_proto_rawDescGZIP() []byte {
+	file_agntcy_oasf_types_v1alpha1_record_proto_rawDescOnce.Do(func() {
+		file_agntcy_oasf_types_v1alpha1_record_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_agntcy_oasf_types_v1alpha1_record_proto_rawDesc), len(file_agntcy_oasf_types_v1alpha1_record_proto_rawDesc)))
+	})
+	return file_agntcy_oasf_types_v1alpha1_record_proto_rawDescData
+}
+
+var file_agntcy_oasf_types_v1alpha1_record_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_agntcy_oasf_types_v1alpha1_record_proto_goTypes = []any{
+	(*Record)(nil),    // 0: agntcy.oasf.types.v1alpha1.Record
+	nil,               // 1: agntcy.oasf.types.v1alpha1.Record.AnnotationsEntry
+	(*Locator)(nil),   // 2: agntcy.oasf.types.v1alpha1.Locator
+	(*Skill)(nil),     // 3: agntcy.oasf.types.v1alpha1.Skill
+	(*Domain)(nil),    // 4: agntcy.oasf.types.v1alpha1.Domain
+	(*Module)(nil),    // 5: agntcy.oasf.types.v1alpha1.Module
+	(*Signature)(nil), // 6: agntcy.oasf.types.v1alpha1.Signature
+}
+var file_agntcy_oasf_types_v1alpha1_record_proto_depIdxs = []int32{
+	1, // 0: agntcy.oasf.types.v1alpha1.Record.annotations:type_name -> agntcy.oasf.types.v1alpha1.Record.AnnotationsEntry
+	2, // 1: agntcy.oasf.types.v1alpha1.Record.locators:type_name -> agntcy.oasf.types.v1alpha1.Locator
+	3, // 2: agntcy.oasf.types.v1alpha1.Record.skills:type_name -> agntcy.oasf.types.v1alpha1.Skill
+	4, // 3: agntcy.oasf.types.v1alpha1.Record.domains:type_name -> agntcy.oasf.types.v1alpha1.Domain
+	5, // 4: agntcy.oasf.types.v1alpha1.Record.modules:type_name -> agntcy.oasf.types.v1alpha1.Module
+	6, // 5: agntcy.oasf.types.v1alpha1.Record.signature:type_name -> agntcy.oasf.types.v1alpha1.Signature
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_agntcy_oasf_types_v1alpha1_record_proto_init() }
+func file_agntcy_oasf_types_v1alpha1_record_proto_init() {
+	if File_agntcy_oasf_types_v1alpha1_record_proto != nil {
+		return
+	}
+	file_agntcy_oasf_types_v1alpha1_domain_proto_init()
+	file_agntcy_oasf_types_v1alpha1_locator_proto_init()
+	file_agntcy_oasf_types_v1alpha1_module_proto_init()
+	file_agntcy_oasf_types_v1alpha1_signature_proto_init()
+	file_agntcy_oasf_types_v1alpha1_skill_proto_init()
+	file_agntcy_oasf_types_v1alpha1_record_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_agntcy_oasf_types_v1alpha1_record_proto_rawDesc), len(file_agntcy_oasf_types_v1alpha1_record_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_agntcy_oasf_types_v1alpha1_record_proto_goTypes,
+		DependencyIndexes: file_agntcy_oasf_types_v1alpha1_record_proto_depIdxs,
+		MessageInfos:      file_agntcy_oasf_types_v1alpha1_record_proto_msgTypes,
+	}.Build()
+	File_agntcy_oasf_types_v1alpha1_record_proto = out.File
+	file_agntcy_oasf_types_v1alpha1_record_proto_goTypes = nil
+	file_agntcy_oasf_types_v1alpha1_record_proto_depIdxs = nil
+}
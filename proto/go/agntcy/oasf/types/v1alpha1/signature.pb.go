@@ -0,0 +1,194 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: agntcy/oasf/types/v1alpha1/signature.proto
+
+package typesv1alpha1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Signature provides the signing and verification details about the record.
+type Signature struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Metadata associated with the signature.
+	Annotations map[string]string `protobuf:"bytes,1,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Signing timestamp of the record in the RFC3339 format.
+	// Specs: https://www.rfc-editor.org/rfc/rfc3339.html
+	SignedAt string `protobuf:"bytes,2,opt,name=signed_at,json=signedAt,proto3" json:"signed_at,omitempty"`
+	// The signature algorithm used (e.g., "ECDSA_P256_SHA256").
+	Algorithm string `protobuf:"bytes,3,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	// Base64-encoded signature.
+	Signature string `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	// Base64-encoded signing certificate.
+	Certificate string `protobuf:"bytes,5,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	// Type of the signature content bundle.
+	ContentType string `protobuf:"bytes,6,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// Base64-encoded signature bundle produced by the signer.
+	// It is up to the client to interpret the content of the bundle.
+	ContentBundle string `protobuf:"bytes,7,opt,name=content_bundle,json=contentBundle,proto3" json:"content_bundle,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Signature) Reset() {
+	*x = Signature{}
+	mi := &file_agntcy_oasf_types_v1alpha1_signature_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Signature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Signature) ProtoMessage() {}
+
+func (x *Signature) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_oasf_types_v1alpha1_signature_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Signature.ProtoReflect.Descriptor instead.
+func (*Signature) Descriptor() ([]byte, []int) {
+	return file_agntcy_oasf_types_v1alpha1_signature_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Signature) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *Signature) GetSignedAt() string {
+	if x != nil {
+		return x.SignedAt
+	}
+	return ""
+}
+
+func (x *Signature) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *Signature) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *Signature) GetCertificate() string {
+	if x != nil {
+		return x.Certificate
+	}
+	return ""
+}
+
+func (x *Signature) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *Signature) GetContentBundle() string {
+	if x != nil {
+		return x.ContentBundle
+	}
+	return ""
+}
+
+var File_agntcy_oasf_types_v1alpha1_signature_proto protoreflect.FileDescriptor
+
+const file_agntcy_oasf_types_v1alpha1_signature_proto_rawDesc = "" +
+	"\n" +
+	"*agntcy/oasf/types/v1alpha1/signature.proto\x12\x1aagntcy.oasf.types.v1alpha1\"\xea\x02\n" +
+	"\tSignature\x12X\n" +
+	"\vannotations\x18\x01 \x03(\v26.agntcy.oasf.types.v1alpha1.Signature.AnnotationsEntryR\vannotations\x12\x1b\n" +
+	"\tsigned_at\x18\x02 \x01(\tR\bsignedAt\x12\x1c\n" +
+	"\talgorithm\x18\x03 \x01(\tR\talgorithm\x12\x1c\n" +
+	"\tsignature\x18\x04 \x01(\tR\tsignature\x12 \n" +
+	"\vcertificate\x18\x05 \x01(\tR\vcertificate\x12!\n" +
+	"\fcontent_type\x18\x06 \x01(\tR\vcontentType\x12%\n" +
+	"\x0econtent_bundle\x18\a \x01(\tR\rcontentBundle\x1a>\n" +
+	"\x10AnnotationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01b\x06proto3"
+
+var (
+	file_agntcy_oasf_types_v1alpha1_signature_proto_rawDescOnce sync.Once
+	file_agntcy_oasf_types_v1alpha1_signature_proto_rawDescData []byte
+)
+
+func file_agntcy_oasf_types_v1alpha1_signature_proto_rawDescGZIP() []byte {
+	file_agntcy_oasf_types_v1alpha1_signature_proto_rawDescOnce.Do(func() {
+		file_agntcy_oasf_types_v1alpha1_signature_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_agntcy_oasf_types_v1alpha1_signature_proto_rawDesc), len(file_agntcy_oasf_types_v1alpha1_signature_proto_rawDesc)))
+	})
+	return file_agntcy_oasf_types_v1alpha1_signature_proto_rawDescData
+}
+
+var file_agntcy_oasf_types_v1alpha1_signature_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_agntcy_oasf_types_v1alpha1_signature_proto_goTypes = []any{
+	(*Signature)(nil), // 0: agntcy.oasf.types.v1alpha1.Signature
+	nil,               // 1: agntcy.oasf.types.v1alpha1.Signature.AnnotationsEntry
+}
+var file_agntcy_oasf_types_v1alpha1_signature_proto_depIdxs = []int32{
+	1, // 0: agntcy.oasf.types.v1alpha1.Signature.annotations:type_name -> agntcy.oasf.types.v1alpha1.Signature.AnnotationsEntry
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_agntcy_oasf_types_v1alpha1_signature_proto_init() }
+func file_agntcy_oasf_types_v1alpha1_signature_proto_init() {
+	if File_agntcy_oasf_types_v1alpha1_signature_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_agntcy_oasf_types_v1alpha1_signature_proto_rawDesc), len(file_agntcy_oasf_types_v1alpha1_signature_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_agntcy_oasf_types_v1alpha1_signature_proto_goTypes,
+		DependencyIndexes: file_agntcy_oasf_types_v1alpha1_signature_proto_depIdxs,
+		MessageInfos:      file_agntcy_oasf_types_v1alpha1_signature_proto_msgTypes,
+	}.Build()
+	File_agntcy_oasf_types_v1alpha1_signature_proto = out.File
+	file_agntcy_oasf_types_v1alpha1_signature_proto_goTypes = nil
+	file_agntcy_oasf_types_v1alpha1_signature_proto_depIdxs = nil
+}
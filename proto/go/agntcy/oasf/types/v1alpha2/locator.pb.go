@@ -0,0 +1,250 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: agntcy/oasf/types/v1alpha2/locator.proto
+
+package typesv1alpha2
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// LocatorType defines placeholders for supported locators.
+// Used in string format across APIs.
+type LocatorType int32
+
+const (
+	LocatorType_LOCATOR_TYPE_UNSPECIFIED    LocatorType = 0 // ""
+	LocatorType_LOCATOR_TYPE_HELM_CHART     LocatorType = 1 // "helm_chart"
+	LocatorType_LOCATOR_TYPE_DOCKER_IMAGE   LocatorType = 2 // "docker_image"
+	LocatorType_LOCATOR_TYPE_PYTHON_PACKAGE LocatorType = 3 // "python_package"
+	LocatorType_LOCATOR_TYPE_SOURCE_CODE    LocatorType = 4 // "source_code"
+	LocatorType_LOCATOR_TYPE_BINARY         LocatorType = 5 // "binary"
+)
+
+// Enum value maps for LocatorType.
+var (
+	LocatorType_name = map[int32]string{
+		0: "LOCATOR_TYPE_UNSPECIFIED",
+		1: "LOCATOR_TYPE_HELM_CHART",
+		2: "LOCATOR_TYPE_DOCKER_IMAGE",
+		3: "LOCATOR_TYPE_PYTHON_PACKAGE",
+		4: "LOCATOR_TYPE_SOURCE_CODE",
+		5: "LOCATOR_TYPE_BINARY",
+	}
+	LocatorType_value = map[string]int32{
+		"LOCATOR_TYPE_UNSPECIFIED":    0,
+		"LOCATOR_TYPE_HELM_CHART":     1,
+		"LOCATOR_TYPE_DOCKER_IMAGE":   2,
+		"LOCATOR_TYPE_PYTHON_PACKAGE": 3,
+		"LOCATOR_TYPE_SOURCE_CODE":    4,
+		"LOCATOR_TYPE_BINARY":         5,
+	}
+)
+
+func (x LocatorType) Enum() *LocatorType {
+	p := new(LocatorType)
+	*p = x
+	return p
+}
+
+func (x LocatorType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LocatorType) Descriptor() protoreflect.EnumDescriptor {
+	return file_agntcy_oasf_types_v1alpha2_locator_proto_enumTypes[0].Descriptor()
+}
+
+func (LocatorType) Type() protoreflect.EnumType {
+	return &file_agntcy_oasf_types_v1alpha2_locator_proto_enumTypes[0]
+}
+
+func (x LocatorType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LocatorType.Descriptor instead.
+func (LocatorType) EnumDescriptor() ([]byte, []int) {
+	return file_agntcy_oasf_types_v1alpha2_locator_proto_rawDescGZIP(), []int{0}
+}
+
+// Locator points to the source where record can be found at.
+// For example, a locator can be a link to a helm chart.
+type Locator struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Type of the locator.
+	// Supports custom types.
+	// Native types are defined in the LocatorType.
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// Location where the source can be found at.
+	// Specs: https://datatracker.ietf.org/doc/html/rfc1738
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// Metadata associated with the locator.
+	Annotations map[string]string `protobuf:"bytes,3,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Size of the source in bytes pointed by the {url} property.
+	Size *uint64 `protobuf:"varint,4,opt,name=size,proto3,oneof" json:"size,omitempty"`
+	// Digest of the source pointed by the {url} property.
+	// Specs: https://github.com/opencontainers/image-spec/blob/main/descriptor.md#digests
+	Digest        *string `protobuf:"bytes,5,opt,name=digest,proto3,oneof" json:"digest,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Locator) Reset() {
+	*x = Locator{}
+	mi := &file_agntcy_oasf_types_v1alpha2_locator_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Locator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Locator) ProtoMessage() {}
+
+func (x *Locator) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_oasf_types_v1alpha2_locator_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Locator.ProtoReflect.Descriptor instead.
+func (*Locator) Descriptor() ([]byte, []int) {
+	return file_agntcy_oasf_types_v1alpha2_locator_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Locator) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Locator) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Locator) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *Locator) GetSize() uint64 {
+	if x != nil && x.Size != nil {
+		return *x.Size
+	}
+	return 0
+}
+
+func (x *Locator) GetDigest() string {
+	if x != nil && x.Digest != nil {
+		return *x.Digest
+	}
+	return ""
+}
+
+var File_agntcy_oasf_types_v1alpha2_locator_proto protoreflect.FileDescriptor
+
+const file_agntcy_oasf_types_v1alpha2_locator_proto_rawDesc = "" +
+	"\n" +
+	"(agntcy/oasf/types/v1alpha2/locator.proto\x12\x1aagntcy.oasf.types.v1alpha2\"\x91\x02\n" +
+	"\aLocator\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12V\n" +
+	"\vannotations\x18\x03 \x03(\v24.agntcy.oasf.types.v1alpha2.Locator.AnnotationsEntryR\vannotations\x12\x17\n" +
+	"\x04size\x18\x04 \x01(\x04H\x00R\x04size\x88\x01\x01\x12\x1b\n" +
+	"\x06digest\x18\x05 \x01(\tH\x01R\x06digest\x88\x01\x01\x1a>\n" +
+	"\x10AnnotationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\a\n" +
+	"\x05_sizeB\t\n" +
+	"\a_digest*\xbf\x01\n" +
+	"\vLocatorType\x12\x1c\n" +
+	"\x18LOCATOR_TYPE_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17LOCATOR_TYPE_HELM_CHART\x10\x01\x12\x1d\n" +
+	"\x19LOCATOR_TYPE_DOCKER_IMAGE\x10\x02\x12\x1f\n" +
+	"\x1bLOCATOR_TYPE_PYTHON_PACKAGE\x10\x03\x12\x1c\n" +
+	"\x18LOCATOR_TYPE_SOURCE_CODE\x10\x04\x12\x17\n" +
+	"\x13LOCATOR_TYPE_BINARY\x10\x05b\x06proto3"
+
+var (
+	file_agntcy_oasf_types_v1alpha2_locator_proto_rawDescOnce sync.Once
+	file_agntcy_oasf_types_v1alpha2_locator_proto_rawDescData []byte
+)
+
+func file_agntcy_oasf_types_v1alpha2_locator_proto_rawDescGZIP() []byte {
+	file_agntcy_oasf_types_v1alpha2_locator_proto_rawDescOnce.Do(func() {
+		file_agntcy_oasf_types_v1alpha2_locator_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_agntcy_oasf_types_v1alpha2_locator_proto_rawDesc), len(file_agntcy_oasf_types_v1alpha2_locator_proto_rawDesc)))
+	})
+	return file_agntcy_oasf_types_v1alpha2_locator_proto_rawDescData
+}
+
+var file_agntcy_oasf_types_v1alpha2_locator_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_agntcy_oasf_types_v1alpha2_locator_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_agntcy_oasf_types_v1alpha2_locator_proto_goTypes = []any{
+	(LocatorType)(0), // 0: agntcy.oasf.types.v1alpha2.LocatorType
+	(*Locator)(nil),  // 1: agntcy.oasf.types.v1alpha2.Locator
+	nil,              // 2: agntcy.oasf.types.v1alpha2.Locator.AnnotationsEntry
+}
+var file_agntcy_oasf_types_v1alpha2_locator_proto_depIdxs = []int32{
+	2, // 0: agntcy.oasf.types.v1alpha2.Locator.annotations:type_name -> agntcy.oasf.types.v1alpha2.Locator.AnnotationsEntry
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_agntcy_oasf_types_v1alpha2_locator_proto_init() }
+func file_agntcy_oasf_types_v1alpha2_locator_proto_init() {
+	if File_agntcy_oasf_types_v1alpha2_locator_proto != nil {
+		return
+	}
+	file_agntcy_oasf_types_v1alpha2_locator_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_agntcy_oasf_types_v1alpha2_locator_proto_rawDesc), len(file_agntcy_oasf_types_v1alpha2_locator_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_agntcy_oasf_types_v1alpha2_locator_proto_goTypes,
+		DependencyIndexes: file_agntcy_oasf_types_v1alpha2_locator_proto_depIdxs,
+		EnumInfos:         file_agntcy_oasf_types_v1alpha2_locator_proto_enumTypes,
+		MessageInfos:      file_agntcy_oasf_types_v1alpha2_locator_proto_msgTypes,
+	}.Build()
+	File_agntcy_oasf_types_v1alpha2_locator_proto = out.File
+	file_agntcy_oasf_types_v1alpha2_locator_proto_goTypes = nil
+	file_agntcy_oasf_types_v1alpha2_locator_proto_depIdxs = nil
+}
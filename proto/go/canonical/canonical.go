@@ -0,0 +1,212 @@
+// Package canonical serializes records into a canonical JSON form and computes
+// their content digests and CIDs.
+//
+// The canonical form is the protojson encoding of a message, using the proto
+// field names and omitting unpopulated fields, re-serialized following the JSON
+// Canonicalization Scheme (RFC 8785): object keys are sorted, numbers are
+// normalized and no insignificant whitespace is emitted. A record read from
+// JSON with protojson and the same record built in Go therefore share their
+// canonical form, digest and CID.
+package canonical
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// Multiformats codes of the CIDs computed by CID. They match the example of the
+// cid_t dictionary type: a version 1 CID with codec 0x01 and a sha2-256
+// multihash, in lowercase base32 multibase.
+const (
+	cidVersion  = 0x01
+	cidCodec    = 0x01
+	sha256Code  = 0x12
+	base32Lower = 'b'
+)
+
+var base32Encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// Marshal returns the canonical JSON form of a message.
+func Marshal(m proto.Message) ([]byte, error) {
+	data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(m)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal %s: %w", m.ProtoReflect().Descriptor().FullName(), err)
+	}
+	return Canonicalize(data)
+}
+
+// Digest returns the sha256 digest of the canonical form of a message, in the
+// "sha256:<hex>" format of descriptor digests.
+func Digest(m proto.Message) (string, error) {
+	sum, err := sum(m)
+	if err != nil {
+		return "", err
+	}
+	return "sha256:" + hex.EncodeToString(sum), nil
+}
+
+// CID returns the content identifier of the canonical form of a message.
+func CID(m proto.Message) (string, error) {
+	sum, err := sum(m)
+	if err != nil {
+		return "", err
+	}
+	cid := []byte{cidVersion}
+	cid = binary.AppendUvarint(cid, cidCodec)
+	cid = binary.AppendUvarint(cid, sha256Code)
+	cid = binary.AppendUvarint(cid, uint64(len(sum)))
+	cid = append(cid, sum...)
+	return string(base32Lower) + strings.ToLower(base32Encoding.EncodeToString(cid)), nil
+}
+
+func sum(m proto.Message) ([]byte, error) {
+	data, err := Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(data)
+	return sum[:], nil
+}
+
+// Canonicalize re-serializes a JSON document in canonical form. Like RFC 8785
+// requires, every number is serialized from its IEEE-754 double value, so
+// integers beyond 2^53 lose precision. protojson encodes 64-bit integer fields
+// as strings, which are kept exact.
+func Canonicalize(data []byte) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, fmt.Errorf("invalid JSON: %w", err)
+	}
+	if decoder.More() {
+		return nil, fmt.Errorf("invalid JSON: unexpected data after the top-level value")
+	}
+	var buf bytes.Buffer
+	if err := encode(&buf, value); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func encode(buf *bytes.Buffer, value any) error {
+	switch value := value.(type) {
+	case nil:
+		buf.WriteString("null")
+	case bool:
+		buf.WriteString(strconv.FormatBool(value))
+	case string:
+		encodeString(buf, value)
+	case json.Number:
+		number, err := normalizeNumber(value)
+		if err != nil {
+			return err
+		}
+		buf.WriteString(number)
+	case []any:
+		buf.WriteByte('[')
+		for i, item := range value {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := encode(buf, item); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte(']')
+	case map[string]any:
+		keys := make([]string, 0, len(value))
+		for key := range value {
+			keys = append(keys, key)
+		}
+		// RFC 8785 sorts keys by their UTF-16 code units.
+		slices.SortFunc(keys, compareUTF16)
+		buf.WriteByte('{')
+		for i, key := range keys {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			encodeString(buf, key)
+			buf.WriteByte(':')
+			if err := encode(buf, value[key]); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte('}')
+	default:
+		return fmt.Errorf("unexpected JSON value of type %T", value)
+	}
+	return nil
+}
+
+func encodeString(buf *bytes.Buffer, s string) {
+	const hexDigits = "0123456789abcdef"
+	buf.WriteByte('"')
+	for _, r := range s {
+		switch {
+		case r == '"' || r == '\\':
+			buf.WriteByte('\\')
+			buf.WriteRune(r)
+		case r == '\b':
+			buf.WriteString(`\b`)
+		case r == '\f':
+			buf.WriteString(`\f`)
+		case r == '\n':
+			buf.WriteString(`\n`)
+		case r == '\r':
+			buf.WriteString(`\r`)
+		case r == '\t':
+			buf.WriteString(`\t`)
+		case r < 0x20:
+			buf.WriteString(`\u00`)
+			buf.WriteByte(hexDigits[r>>4])
+			buf.WriteByte(hexDigits[r&0xf])
+		default:
+			buf.WriteRune(r)
+		}
+	}
+	buf.WriteByte('"')
+}
+
+func normalizeNumber(number json.Number) (string, error) {
+	literal := number.String()
+	value, err := strconv.ParseFloat(literal, 64)
+	if err != nil || math.IsInf(value, 0) {
+		return "", fmt.Errorf("invalid number %s", literal)
+	}
+	return formatFloat(value), nil
+}
+
+// formatFloat formats a number like ECMAScript Number.prototype.toString, as
+// required by RFC 8785.
+func formatFloat(value float64) string {
+	if value == 0 {
+		return "0"
+	}
+	abs := math.Abs(value)
+	if abs >= 1e-6 && abs < 1e21 {
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	}
+	s := strconv.FormatFloat(value, 'e', -1, 64)
+	mantissa, exponent, _ := strings.Cut(s, "e")
+	sign := exponent[:1]
+	exponent = strings.TrimLeft(exponent[1:], "0")
+	return mantissa + "e" + sign + exponent
+}
+
+func compareUTF16(a, b string) int {
+	return slices.Compare(utf16.Encode([]rune(a)), utf16.Encode([]rune(b)))
+}
@@ -0,0 +1,117 @@
+package canonical_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	typesv1 "github.com/agntcy/oasf/proto/go/agntcy/oasf/types/v1"
+	"github.com/agntcy/oasf/proto/go/canonical"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// cidPattern is the regex of the cid_t dictionary type.
+const cidPattern = `^(Qm[1-9A-HJ-NP-Za-km-z]{44}|b[a-z2-7]{46,}|z[1-9A-HJ-NP-Za-km-z]+|m[A-Za-z0-9+/=]+)$`
+
+func canonicalize(data string) string {
+	canonical, err := canonical.Canonicalize([]byte(data))
+	Expect(err).NotTo(HaveOccurred())
+	return string(canonical)
+}
+
+var _ = Describe("Canonical JSON", func() {
+	It("should sort keys and drop whitespace", func() {
+		Expect(canonicalize(`{ "b": [1, {"d": true, "c": null}], "a": "x" }`)).To(Equal(`{"a":"x","b":[1,{"c":null,"d":true}]}`))
+	})
+
+	It("should sort keys by UTF-16 code units", func() {
+		Expect(canonicalize(`{"דּ": 1, "😀": 2, "€": 3}`)).To(Equal(`{"€":3,"😀":2,"דּ":1}`))
+	})
+
+	It("should normalize numbers", func() {
+		Expect(canonicalize(`[1.0, 1e2, 1E-7, 1e21, -0.0, 0.5, 12345678901234567890]`)).To(Equal(`[1,100,1e-7,1e+21,0,0.5,12345678901234567000]`))
+		// Numbers are serialized from their double value, whatever their literal.
+		Expect(canonicalize(`12345678901234567890`)).To(Equal(canonicalize(`1.2345678901234567890e19`)))
+	})
+
+	It("should escape strings minimally", func() {
+		Expect(canonicalize(`"é<>&\"\\\n\u001f\/"`)).To(Equal(`"é<>&\"\\\n\u001f/"`))
+	})
+
+	It("should reject invalid JSON", func() {
+		_, err := canonical.Canonicalize([]byte(`{"a": 1} {}`))
+		Expect(err).To(HaveOccurred())
+		_, err = canonical.Canonicalize([]byte(`{"a":`))
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("Record digests", func() {
+	var record *typesv1.Record
+
+	BeforeEach(func() {
+		data, err := structpb.NewStruct(map[string]any{"protocol_version": "0.3.0", "port": 8080})
+		Expect(err).NotTo(HaveOccurred())
+		record = &typesv1.Record{
+			Name:          "agent",
+			Version:       "v1.0.0",
+			SchemaVersion: "1.0.0",
+			Authors:       []string{"AGNTCY"},
+			CreatedAt:     "2025-01-01T00:00:00Z",
+			Skills:        []*typesv1.Skill{{Name: "natural_language_processing/text_completion", Id: 10201}},
+			Modules:       []*typesv1.Module{{Name: "integration/a2a", Id: 203, Data: data}},
+		}
+	})
+
+	It("should marshal records canonically", func() {
+		data, err := canonical.Marshal(record)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(data)).To(Equal(`{"authors":["AGNTCY"],"created_at":"2025-01-01T00:00:00Z",` +
+			`"modules":[{"data":{"port":8080,"protocol_version":"0.3.0"},"id":203,"name":"integration/a2a"}],` +
+			`"name":"agent","schema_version":"1.0.0",` +
+			`"skills":[{"id":10201,"name":"natural_language_processing/text_completion"}],"version":"v1.0.0"}`))
+
+		digest, err := canonical.Digest(record)
+		Expect(err).NotTo(HaveOccurred())
+		sum := sha256.Sum256(data)
+		Expect(digest).To(Equal("sha256:" + hex.EncodeToString(sum[:])))
+	})
+
+	It("should hash equivalent JSON and protobuf records identically", func() {
+		fromJSON := &typesv1.Record{}
+		Expect(protojson.Unmarshal([]byte(`{
+			"version": "v1.0.0",
+			"schemaVersion": "1.0.0",
+			"name": "agent",
+			"description": "",
+			"annotations": {},
+			"locators": [],
+			"authors": ["AGNTCY"],
+			"created_at": "2025-01-01T00:00:00Z",
+			"skills": [{"id": 10201.0, "name": "natural_language_processing/text_completion"}],
+			"modules": [{"name": "integration/a2a", "id": 203, "data": {"port": 8.08e3, "protocol_version": "0.3.0"}}]
+		}`), fromJSON)).To(Succeed())
+
+		expected, err := canonical.Digest(record)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(canonical.Digest(fromJSON)).To(Equal(expected))
+
+		expectedCID, err := canonical.CID(record)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(canonical.CID(fromJSON)).To(Equal(expectedCID))
+
+		record.Description = "changed"
+		Expect(canonical.Digest(record)).NotTo(Equal(expected))
+	})
+
+	It("should compute CIDs in the cid_t format", func() {
+		cid, err := canonical.CID(record)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cid).To(MatchRegexp(cidPattern))
+		// Version 1, codec 0x01 and a sha2-256 multihash, like the cid_t example.
+		Expect(cid).To(HavePrefix("baearei"))
+		Expect(cid).To(HaveLen(len("baeareiccegmypgujc6ru6f3nbib2a4ojuzfz2t5ugtfux4a4rtfru3g2sm")))
+	})
+})
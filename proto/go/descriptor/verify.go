@@ -0,0 +1,180 @@
+// Package descriptor verifies the payloads referenced by module artifact
+// descriptors against their size and digest.
+package descriptor
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"math"
+	"strings"
+
+	typesv1 "github.com/agntcy/oasf/proto/go/agntcy/oasf/types/v1"
+	"github.com/agntcy/oasf/proto/go/canonical"
+)
+
+// Error codes of verification errors.
+const (
+	CodePayloadMissing    = "payload_missing"
+	CodeDigestMissing     = "digest_missing"
+	CodeDigestUnsupported = "digest_unsupported"
+	CodeDigestMismatch    = "digest_mismatch"
+	CodeSizeMismatch      = "size_mismatch"
+	CodeSizeInvalid       = "size_invalid"
+	CodeDataJSONMismatch  = "data_json_mismatch"
+	CodeFetchFailed       = "fetch_failed"
+	CodeURLUnverified     = "url_unverified"
+)
+
+// Error is a single verification failure of a descriptor.
+type Error struct {
+	Code string
+	// Field is the descriptor field the failure is about, e.g. data or urls[0].
+	Field   string
+	Message string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s: %s", e.Field, e.Message)
+}
+
+// Algorithms maps the supported digest algorithm prefixes to their hash.
+var Algorithms = map[string]func() hash.Hash{
+	"sha256": sha256.New,
+	"sha512": sha512.New,
+}
+
+// Option configures a Verifier.
+type Option func(*Verifier)
+
+// WithFetcher sets the fetcher used to retrieve the payloads of descriptor urls.
+// Without a fetcher, every url fails with CodeURLUnverified.
+func WithFetcher(fetcher Fetcher) Option {
+	return func(v *Verifier) {
+		v.fetcher = fetcher
+	}
+}
+
+// Verifier checks descriptor payloads.
+type Verifier struct {
+	fetcher Fetcher
+}
+
+// NewVerifier returns a verifier configured with the given options.
+func NewVerifier(opts ...Option) *Verifier {
+	v := &Verifier{}
+	for _, opt := range opts {
+		opt(v)
+	}
+	return v
+}
+
+// Verify checks every payload of the descriptor against its size and digest:
+// the inline data, the inline json when there is no data, and the payload of
+// each url. The json payload is hashed in canonical JSON form. When both data
+// and json are set, the data must be the JSON encoding of the json payload.
+// Urls cannot be verified without a fetcher and are reported as failures.
+// All failures are returned, joined, as *Error values.
+func (v *Verifier) Verify(ctx context.Context, d *typesv1.Descriptor) error {
+	var errs []error
+	fail := func(code, field, format string, args ...any) {
+		errs = append(errs, &Error{Code: code, Field: field, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if len(d.GetData()) == 0 && d.GetJson() == nil && len(d.GetUrls()) == 0 {
+		fail(CodePayloadMissing, "urls", "at least one of urls, data and json is required")
+		return errors.Join(errs...)
+	}
+	algorithm, expected, err := parseDigest(d.GetDigest())
+	if err != nil {
+		errs = append(errs, err)
+		return errors.Join(errs...)
+	}
+	if d.GetSize() >= math.MaxInt64 {
+		fail(CodeSizeInvalid, "size", "size %d is too large", d.GetSize())
+		return errors.Join(errs...)
+	}
+
+	check := func(field string, payload io.Reader) {
+		h := Algorithms[algorithm]()
+		// Read one byte more than expected so that oversized payloads are
+		// detected without reading them in full.
+		size, err := io.Copy(h, io.LimitReader(payload, int64(d.GetSize())+1))
+		if err != nil {
+			fail(CodeFetchFailed, field, "failed to read payload: %s", err)
+			return
+		}
+		if uint64(size) != d.GetSize() {
+			if uint64(size) > d.GetSize() {
+				fail(CodeSizeMismatch, field, "payload is larger than the size %d", d.GetSize())
+			} else {
+				fail(CodeSizeMismatch, field, "payload has %d bytes, expected %d", size, d.GetSize())
+			}
+			return
+		}
+		if actual := hex.EncodeToString(h.Sum(nil)); actual != expected {
+			fail(CodeDigestMismatch, field, "payload has digest %s:%s, expected %s", algorithm, actual, d.GetDigest())
+		}
+	}
+
+	var jsonPayload []byte
+	if d.GetJson() != nil {
+		if jsonPayload, err = canonical.Marshal(d.GetJson()); err != nil {
+			fail(CodeDataJSONMismatch, "json", "failed to serialize: %s", err)
+		}
+	}
+	switch {
+	case len(d.GetData()) > 0:
+		check("data", bytes.NewReader(d.GetData()))
+		if jsonPayload != nil {
+			data, err := canonical.Canonicalize(d.GetData())
+			if err != nil {
+				fail(CodeDataJSONMismatch, "data", "data is not JSON while json is set: %s", err)
+			} else if !bytes.Equal(data, jsonPayload) {
+				fail(CodeDataJSONMismatch, "json", "json differs from the JSON payload of data")
+			}
+		}
+	case jsonPayload != nil:
+		check("json", bytes.NewReader(jsonPayload))
+	}
+
+	for i, url := range d.GetUrls() {
+		field := fmt.Sprintf("urls[%d]", i)
+		if v.fetcher == nil {
+			fail(CodeURLUnverified, field, "no fetcher is configured to verify %s", url)
+			continue
+		}
+		payload, err := v.fetcher.Fetch(ctx, url)
+		if err != nil {
+			fail(CodeFetchFailed, field, "failed to fetch %s: %s", url, err)
+			continue
+		}
+		check(field, payload)
+		payload.Close()
+	}
+	return errors.Join(errs...)
+}
+
+// parseDigest splits a digest in the "<algorithm>:<hex>" format.
+func parseDigest(digest string) (string, string, error) {
+	if digest == "" {
+		return "", "", &Error{Code: CodeDigestMissing, Field: "digest", Message: "digest is required"}
+	}
+	algorithm, encoded, found := strings.Cut(digest, ":")
+	newHash, supported := Algorithms[algorithm]
+	if !found || !supported {
+		return "", "", &Error{Code: CodeDigestUnsupported, Field: "digest",
+			Message: fmt.Sprintf("unsupported digest %q, expected sha256:<hex> or sha512:<hex>", digest)}
+	}
+	if _, err := hex.DecodeString(encoded); err != nil || len(encoded) != 2*newHash().Size() || strings.ToLower(encoded) != encoded {
+		return "", "", &Error{Code: CodeDigestUnsupported, Field: "digest",
+			Message: fmt.Sprintf("digest %q is not a lowercase hex %s digest", digest, algorithm)}
+	}
+	return algorithm, encoded, nil
+}
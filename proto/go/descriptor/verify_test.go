@@ -0,0 +1,163 @@
+package descriptor_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/http/httptest"
+
+	typesv1 "github.com/agntcy/oasf/proto/go/agntcy/oasf/types/v1"
+	"github.com/agntcy/oasf/proto/go/descriptor"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+var payload = []byte(`{"name": "server", "version": "1.0.0"}`)
+
+func sha256Digest(data []byte) string {
+	sum := sha256.Sum256(data)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+func codes(err error) []string {
+	var result []string
+	for _, err := range err.(interface{ Unwrap() []error }).Unwrap() {
+		var verifyErr *descriptor.Error
+		Expect(errors.As(err, &verifyErr)).To(BeTrue())
+		result = append(result, verifyErr.Code+" "+verifyErr.Field)
+	}
+	return result
+}
+
+// localFetcher serves payloads from memory.
+func localFetcher(payloads map[string][]byte) descriptor.Fetcher {
+	return descriptor.FetcherFunc(func(_ context.Context, url string) (io.ReadCloser, error) {
+		data, ok := payloads[url]
+		if !ok {
+			return nil, fmt.Errorf("not found")
+		}
+		return io.NopCloser(bytes.NewReader(data)), nil
+	})
+}
+
+var _ = Describe("Descriptor verification", func() {
+	var ctx context.Context
+	var verifier *descriptor.Verifier
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		verifier = descriptor.NewVerifier()
+	})
+
+	It("should verify inline data", func() {
+		d := &typesv1.Descriptor{MediaType: "application/json", Data: payload, Size: uint64(len(payload)), Digest: sha256Digest(payload)}
+		Expect(verifier.Verify(ctx, d)).To(Succeed())
+
+		sum := sha512.Sum512(payload)
+		d.Digest = "sha512:" + hex.EncodeToString(sum[:])
+		Expect(verifier.Verify(ctx, d)).To(Succeed())
+	})
+
+	It("should report size and digest mismatches", func() {
+		d := &typesv1.Descriptor{Data: payload, Size: uint64(len(payload)) - 1, Digest: sha256Digest(payload)}
+		err := verifier.Verify(ctx, d)
+		Expect(codes(err)).To(Equal([]string{"size_mismatch data"}))
+		Expect(err).To(MatchError(ContainSubstring("payload is larger than the size")))
+
+		d = &typesv1.Descriptor{Data: payload, Size: uint64(len(payload)) + 1, Digest: sha256Digest(payload)}
+		Expect(verifier.Verify(ctx, d)).To(MatchError(ContainSubstring(fmt.Sprintf("payload has %d bytes", len(payload)))))
+
+		d = &typesv1.Descriptor{Data: payload, Size: uint64(len(payload)), Digest: sha256Digest([]byte("other"))}
+		Expect(codes(verifier.Verify(ctx, d))).To(Equal([]string{"digest_mismatch data"}))
+	})
+
+	It("should reject missing, unsupported and malformed digests", func() {
+		d := &typesv1.Descriptor{Data: payload, Size: uint64(len(payload))}
+		Expect(codes(verifier.Verify(ctx, d))).To(Equal([]string{"digest_missing digest"}))
+
+		d.Digest = "md5:" + hex.EncodeToString(make([]byte, 16))
+		Expect(codes(verifier.Verify(ctx, d))).To(Equal([]string{"digest_unsupported digest"}))
+
+		d.Digest = "sha256:abc"
+		Expect(codes(verifier.Verify(ctx, d))).To(Equal([]string{"digest_unsupported digest"}))
+	})
+
+	It("should reject sizes that cannot be read", func() {
+		d := &typesv1.Descriptor{Data: payload, Size: math.MaxUint64, Digest: sha256Digest(payload)}
+		Expect(codes(verifier.Verify(ctx, d))).To(Equal([]string{"size_invalid size"}))
+
+		d.Size = math.MaxInt64
+		Expect(codes(verifier.Verify(ctx, d))).To(Equal([]string{"size_invalid size"}))
+	})
+
+	It("should require a payload", func() {
+		d := &typesv1.Descriptor{Digest: sha256Digest(nil)}
+		Expect(codes(verifier.Verify(ctx, d))).To(Equal([]string{"payload_missing urls"}))
+	})
+
+	It("should check that data and json agree", func() {
+		json, err := structpb.NewStruct(map[string]any{"version": "1.0.0", "name": "server"})
+		Expect(err).NotTo(HaveOccurred())
+		d := &typesv1.Descriptor{Data: payload, Json: json, Size: uint64(len(payload)), Digest: sha256Digest(payload)}
+		Expect(verifier.Verify(ctx, d)).To(Succeed())
+
+		json.Fields["name"] = structpb.NewStringValue("other")
+		Expect(codes(verifier.Verify(ctx, d))).To(Equal([]string{"data_json_mismatch json"}))
+	})
+
+	It("should verify a json payload in canonical form", func() {
+		json, err := structpb.NewStruct(map[string]any{"version": "1.0.0", "name": "server"})
+		Expect(err).NotTo(HaveOccurred())
+		canonical := []byte(`{"name":"server","version":"1.0.0"}`)
+		d := &typesv1.Descriptor{Json: json, Size: uint64(len(canonical)), Digest: sha256Digest(canonical)}
+		Expect(verifier.Verify(ctx, d)).To(Succeed())
+	})
+
+	It("should verify url payloads through the fetcher", func() {
+		d := &typesv1.Descriptor{
+			Urls:   []string{"https://example.com/a", "https://example.com/b", "https://example.com/missing"},
+			Size:   uint64(len(payload)),
+			Digest: sha256Digest(payload),
+		}
+		Expect(codes(verifier.Verify(ctx, d))).To(Equal([]string{
+			"url_unverified urls[0]",
+			"url_unverified urls[1]",
+			"url_unverified urls[2]",
+		}))
+
+		verifier = descriptor.NewVerifier(descriptor.WithFetcher(localFetcher(map[string][]byte{
+			"https://example.com/a": payload,
+			"https://example.com/b": []byte("tampered"),
+		})))
+		Expect(codes(verifier.Verify(ctx, d))).To(Equal([]string{
+			"size_mismatch urls[1]",
+			"fetch_failed urls[2]",
+		}))
+	})
+
+	It("should fetch payloads over HTTP", func() {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/payload" {
+				http.NotFound(w, r)
+				return
+			}
+			_, _ = w.Write(payload)
+		}))
+		defer server.Close()
+
+		verifier = descriptor.NewVerifier(descriptor.WithFetcher(descriptor.HTTPFetcher{Client: server.Client()}))
+		d := &typesv1.Descriptor{Urls: []string{server.URL + "/payload"}, Size: uint64(len(payload)), Digest: sha256Digest(payload)}
+		Expect(verifier.Verify(ctx, d)).To(Succeed())
+
+		d.Urls = []string{server.URL + "/missing"}
+		Expect(verifier.Verify(ctx, d)).To(MatchError(ContainSubstring("unexpected status 404")))
+	})
+})
@@ -0,0 +1,333 @@
+package migrate
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	typesv1 "github.com/agntcy/oasf/proto/go/agntcy/oasf/types/v1"
+	typesv1alpha0 "github.com/agntcy/oasf/proto/go/agntcy/oasf/types/v1alpha0"
+	typesv1alpha1 "github.com/agntcy/oasf/proto/go/agntcy/oasf/types/v1alpha1"
+	typesv1alpha2 "github.com/agntcy/oasf/proto/go/agntcy/oasf/types/v1alpha2"
+	"google.golang.org/protobuf/proto"
+)
+
+// Option configures a downgrade.
+type Option func(*options)
+
+type options struct {
+	lenient bool
+	// keepAnnotations leaves skill and domain annotations to a later step
+	// instead of dropping them, for downgrades past v1alpha2.
+	keepAnnotations bool
+}
+
+// Lenient drops the values the target version cannot represent instead of
+// failing. The dropped values are still listed in the report.
+func Lenient() Option {
+	return func(o *options) {
+		o.lenient = true
+	}
+}
+
+// UnrepresentableError is returned by a downgrade when the target version
+// cannot represent some values of the record and Lenient was not given.
+type UnrepresentableError struct {
+	To     string
+	Fields []string
+}
+
+func (e *UnrepresentableError) Error() string {
+	return fmt.Sprintf("%s cannot represent %s", e.To, strings.Join(e.Fields, ", "))
+}
+
+// downgrade collects the changes of a single downgrade step.
+type downgrade struct {
+	options
+	report *Report
+	// unrepresentable holds the dropped fields that fail the step unless lenient.
+	unrepresentable []string
+}
+
+func newDowngrade(from, to string, opts []Option) *downgrade {
+	d := &downgrade{report: &Report{From: from, To: to}}
+	for _, opt := range opts {
+		opt(&d.options)
+	}
+	return d
+}
+
+// drop records a value the target version cannot represent.
+func (d *downgrade) drop(field, format string, args ...any) {
+	d.report.add(Dropped, field, format, args...)
+	d.unrepresentable = append(d.unrepresentable, field)
+}
+
+// warn records a value that is always dropped, such as module artifacts.
+func (d *downgrade) warn(field, format string, args ...any) {
+	d.report.add(Dropped, field, format, args...)
+}
+
+func (d *downgrade) err() error {
+	if d.lenient || len(d.unrepresentable) == 0 {
+		return nil
+	}
+	return &UnrepresentableError{To: d.report.To, Fields: d.unrepresentable}
+}
+
+// FromV1 downgrades a v1 record to the given version, one version at a time,
+// and returns the report of every step taken. Skill and domain annotations,
+// which v1alpha2 cannot hold, are carried over to v1alpha1 and v1alpha0 and only
+// reported where the target cannot hold them. On failure, the reports up to and
+// including the failed step are returned along with the error.
+func FromV1(record *typesv1.Record, version string, opts ...Option) (proto.Message, []*Report, error) {
+	switch version {
+	case V1, V1alpha2, V1alpha1, V1alpha0:
+	default:
+		return nil, nil, fmt.Errorf("unsupported version %q", version)
+	}
+	if version == V1 {
+		return record, nil, nil
+	}
+
+	var reports []*Report
+	steps := opts
+	if version != V1alpha2 {
+		steps = append(slices.Clone(opts), func(o *options) { o.keepAnnotations = true })
+	}
+	v1alpha2, report, err := V1ToV1alpha2(record, steps...)
+	reports = append(reports, report)
+	if err != nil {
+		return nil, reports, err
+	}
+	if version == V1alpha2 {
+		return v1alpha2, reports, nil
+	}
+	v1alpha1, report, err := V1alpha2ToV1alpha1(v1alpha2, opts...)
+	reports = append(reports, report)
+	if err != nil {
+		return nil, reports, err
+	}
+	for i, skill := range record.GetSkills() {
+		v1alpha1.Skills[i].Annotations = maps.Clone(skill.GetAnnotations())
+	}
+	for i, domain := range record.GetDomains() {
+		v1alpha1.Domains[i].Annotations = maps.Clone(domain.GetAnnotations())
+	}
+	if version == V1alpha1 {
+		return v1alpha1, reports, nil
+	}
+	v1alpha0, report, err := V1alpha1ToV1alpha0(v1alpha1, opts...)
+	reports = append(reports, report)
+	if err != nil {
+		return nil, reports, err
+	}
+	return v1alpha0, reports, nil
+}
+
+// V1ToV1alpha2 downgrades a v1 record. Module artifacts are always dropped
+// with a warning. Locators with several urls or a type the alpha versions do
+// not define, and annotated skills or domains cannot be represented.
+func V1ToV1alpha2(record *typesv1.Record, opts ...Option) (*typesv1alpha2.Record, *Report, error) {
+	d := newDowngrade(V1, V1alpha2, opts)
+	downgraded := &typesv1alpha2.Record{
+		Name:          record.GetName(),
+		Version:       record.GetVersion(),
+		SchemaVersion: record.GetSchemaVersion(),
+		Description:   record.GetDescription(),
+		Authors:       slices.Clone(record.GetAuthors()),
+		Annotations:   maps.Clone(record.GetAnnotations()),
+		CreatedAt:     record.GetCreatedAt(),
+	}
+
+	for i, locator := range record.GetLocators() {
+		field := index("locators", i)
+		converted := &typesv1alpha2.Locator{Annotations: maps.Clone(locator.GetAnnotations())}
+		d.locatorType(join(field, "type"), locator.GetType(), converted)
+		if urls := locator.GetUrls(); len(urls) > 0 {
+			converted.Url = urls[0]
+			d.report.add(Converted, join(field, "urls"), "became url")
+			for j := 1; j < len(urls); j++ {
+				d.drop(index(join(field, "urls"), j), "locators have a single url in %s", V1alpha2)
+			}
+		}
+		downgraded.Locators = append(downgraded.Locators, converted)
+	}
+	for i, skill := range record.GetSkills() {
+		downgraded.Skills = append(downgraded.Skills, &typesv1alpha2.Skill{Name: skill.GetName(), Id: skill.GetId()})
+		if len(skill.GetAnnotations()) > 0 && !d.keepAnnotations {
+			d.drop(join(index("skills", i), "annotations"), "skills have no annotations in %s", V1alpha2)
+		}
+	}
+	for i, domain := range record.GetDomains() {
+		downgraded.Domains = append(downgraded.Domains, &typesv1alpha2.Domain{Name: domain.GetName(), Id: domain.GetId()})
+		if len(domain.GetAnnotations()) > 0 && !d.keepAnnotations {
+			d.drop(join(index("domains", i), "annotations"), "domains have no annotations in %s", V1alpha2)
+		}
+	}
+	for i, module := range record.GetModules() {
+		downgraded.Modules = append(downgraded.Modules, &typesv1alpha2.Module{
+			Name:        module.GetName(),
+			Id:          module.GetId(),
+			Annotations: maps.Clone(module.GetAnnotations()),
+			Data:        proto.CloneOf(module.GetData()),
+		})
+		if artifact := module.GetArtifact(); artifact != nil {
+			d.warn(join(index("modules", i), "artifact"), "modules have no artifact in %s, %s %s was dropped",
+				V1alpha2, artifact.GetMediaType(), artifact.GetDigest())
+		}
+	}
+
+	d.report.renumbered("", record.ProtoReflect(), downgraded.ProtoReflect())
+	if err := d.err(); err != nil {
+		return nil, d.report, err
+	}
+	return downgraded, d.report, nil
+}
+
+// locatorType sets the alpha locator type of a v1 locator type. Types the
+// alpha versions do not define are dropped.
+func (d *downgrade) locatorType(field, value string, converted *typesv1alpha2.Locator) {
+	switch downgraded := downgradeLocatorType(value); {
+	case downgraded == value && slices.Contains(alphaLocatorTypes, value):
+		converted.Type = value
+	case downgraded == "python_package":
+		converted.Type = downgraded
+		d.report.add(Converted, field, "%q became %q, assuming a Python package", value, downgraded)
+	case downgraded != value:
+		converted.Type = downgraded
+		d.report.add(Converted, field, "%q became %q", value, downgraded)
+	default:
+		d.drop(field, "locator type %q is not defined in %s", value, d.report.To)
+	}
+}
+
+// V1alpha2ToV1alpha1 downgrades a v1alpha2 record. Every v1alpha2 value can be
+// represented in v1alpha1, so it never fails; the error is kept for symmetry
+// with the other steps.
+func V1alpha2ToV1alpha1(record *typesv1alpha2.Record, opts ...Option) (*typesv1alpha1.Record, *Report, error) {
+	d := newDowngrade(V1alpha2, V1alpha1, opts)
+	downgraded := &typesv1alpha1.Record{
+		Name:              record.GetName(),
+		Version:           record.GetVersion(),
+		SchemaVersion:     record.GetSchemaVersion(),
+		Description:       record.GetDescription(),
+		Authors:           slices.Clone(record.GetAuthors()),
+		Annotations:       maps.Clone(record.GetAnnotations()),
+		CreatedAt:         record.GetCreatedAt(),
+		PreviousRecordCid: record.PreviousRecordCid,
+	}
+
+	for _, locator := range record.GetLocators() {
+		downgraded.Locators = append(downgraded.Locators, &typesv1alpha1.Locator{
+			Type:        locator.GetType(),
+			Url:         locator.GetUrl(),
+			Annotations: maps.Clone(locator.GetAnnotations()),
+			Size:        locator.Size,
+			Digest:      locator.Digest,
+		})
+	}
+	for _, skill := range record.GetSkills() {
+		downgraded.Skills = append(downgraded.Skills, &typesv1alpha1.Skill{Name: skill.GetName(), Id: skill.GetId()})
+	}
+	for _, domain := range record.GetDomains() {
+		downgraded.Domains = append(downgraded.Domains, &typesv1alpha1.Domain{Name: domain.GetName(), Id: domain.GetId()})
+	}
+	for _, module := range record.GetModules() {
+		downgraded.Modules = append(downgraded.Modules, &typesv1alpha1.Module{
+			Name:        module.GetName(),
+			Id:          module.GetId(),
+			Annotations: maps.Clone(module.GetAnnotations()),
+			Data:        proto.CloneOf(module.GetData()),
+		})
+	}
+
+	d.report.renumbered("", record.ProtoReflect(), downgraded.ProtoReflect())
+	return downgraded, d.report, nil
+}
+
+// V1alpha1ToV1alpha0 downgrades a v1alpha1 record. Skill names are split into
+// category and class names and modules become extensions. Domains, module ids
+// and the previous record CID cannot be represented.
+func V1alpha1ToV1alpha0(record *typesv1alpha1.Record, opts ...Option) (*typesv1alpha0.Record, *Report, error) {
+	d := newDowngrade(V1alpha1, V1alpha0, opts)
+	downgraded := &typesv1alpha0.Record{
+		SchemaVersion: record.GetSchemaVersion(),
+		Name:          record.GetName(),
+		Version:       record.GetVersion(),
+		Description:   record.GetDescription(),
+		Authors:       slices.Clone(record.GetAuthors()),
+		CreatedAt:     record.GetCreatedAt(),
+		Annotations:   maps.Clone(record.GetAnnotations()),
+	}
+
+	for i, skill := range record.GetSkills() {
+		field := index("skills", i)
+		converted := &typesv1alpha0.Skill{
+			Annotations: maps.Clone(skill.GetAnnotations()),
+			ClassUid:    uint64(skill.GetId()),
+		}
+		category, class, found := strings.Cut(skill.GetName(), "/")
+		if !found {
+			category, class = "", category
+		}
+		if category != "" {
+			converted.CategoryName = proto.String(category)
+			d.report.add(Converted, join(field, "name"), "split into category_name and class_name")
+		}
+		if class != "" {
+			converted.ClassName = proto.String(class)
+		}
+		if skill.GetId() != 0 {
+			d.report.add(Converted, join(field, "id"), "became class_uid")
+		}
+		downgraded.Skills = append(downgraded.Skills, converted)
+	}
+	for _, locator := range record.GetLocators() {
+		downgraded.Locators = append(downgraded.Locators, &typesv1alpha0.Locator{
+			Type:        locator.GetType(),
+			Url:         locator.GetUrl(),
+			Annotations: maps.Clone(locator.GetAnnotations()),
+			Size:        locator.Size,
+			Digest:      locator.Digest,
+		})
+	}
+	for i := range record.GetDomains() {
+		d.drop(index("domains", i), "records have no domains in %s", V1alpha0)
+	}
+	for i, module := range record.GetModules() {
+		field := index("modules", i)
+		downgraded.Extensions = append(downgraded.Extensions, &typesv1alpha0.Extension{
+			Name:        module.GetName(),
+			Annotations: maps.Clone(module.GetAnnotations()),
+			Data:        proto.CloneOf(module.GetData()),
+		})
+		d.report.add(Converted, field, "became extension %q", module.GetName())
+		if module.GetId() != 0 {
+			d.drop(join(field, "id"), "extensions have no id in %s, %d was dropped", V1alpha0, module.GetId())
+		}
+	}
+	if signature := record.GetSignature(); signature != nil {
+		downgraded.Signature = &typesv1alpha0.Signature{
+			Algorithm:     signature.GetAlgorithm(),
+			Signature:     signature.GetSignature(),
+			Certificate:   signature.GetCertificate(),
+			ContentType:   signature.GetContentType(),
+			ContentBundle: signature.GetContentBundle(),
+			SignedAt:      signature.GetSignedAt(),
+		}
+		if len(signature.GetAnnotations()) > 0 {
+			d.drop("signature.annotations", "signatures have no annotations in %s", V1alpha0)
+		}
+	}
+	if record.PreviousRecordCid != nil {
+		d.drop("previous_record_cid", "records are not linked in %s", V1alpha0)
+	}
+
+	d.report.renumbered("", record.ProtoReflect(), downgraded.ProtoReflect())
+	if err := d.err(); err != nil {
+		return nil, d.report, err
+	}
+	return downgraded, d.report, nil
+}
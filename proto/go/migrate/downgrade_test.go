@@ -0,0 +1,180 @@
+package migrate_test
+
+import (
+	"errors"
+
+	typesv1 "github.com/agntcy/oasf/proto/go/agntcy/oasf/types/v1"
+	typesv1alpha0 "github.com/agntcy/oasf/proto/go/agntcy/oasf/types/v1alpha0"
+	typesv1alpha1 "github.com/agntcy/oasf/proto/go/agntcy/oasf/types/v1alpha1"
+	typesv1alpha2 "github.com/agntcy/oasf/proto/go/agntcy/oasf/types/v1alpha2"
+	"github.com/agntcy/oasf/proto/go/migrate"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+var _ = Describe("Downgrade", func() {
+	var record *typesv1.Record
+
+	BeforeEach(func() {
+		data, err := structpb.NewStruct(map[string]any{"protocol_version": "0.3.0"})
+		Expect(err).NotTo(HaveOccurred())
+		record = &typesv1.Record{
+			Name:          "agent",
+			Version:       "v1.0.0",
+			SchemaVersion: "1.0.0",
+			Locators:      []*typesv1.Locator{{Type: "container_image", Urls: []string{"ghcr.io/agntcy/agent"}}},
+			Skills:        []*typesv1.Skill{{Name: "natural_language_processing/text_completion", Id: 10201}},
+			Modules: []*typesv1.Module{{
+				Name:     "integration/a2a",
+				Id:       203,
+				Data:     data,
+				Artifact: &typesv1.Descriptor{MediaType: "application/json", Digest: "sha256:00"},
+			}},
+		}
+	})
+
+	It("should drop module artifacts with a warning", func() {
+		downgraded, report, err := migrate.V1ToV1alpha2(record)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(downgraded.GetLocators()[0].GetType()).To(Equal("docker_image"))
+		Expect(downgraded.GetLocators()[0].GetUrl()).To(Equal("ghcr.io/agntcy/agent"))
+		Expect(downgraded.GetModules()[0].GetId()).To(BeEquivalentTo(203))
+		Expect(proto.Equal(downgraded.GetModules()[0].GetData(), record.GetModules()[0].GetData())).To(BeTrue())
+		Expect(report.Warnings()).To(Equal([]string{
+			"modules[0].artifact: dropped: modules have no artifact in v1alpha2, application/json sha256:00 was dropped",
+		}))
+		Expect(report.Changes).To(ContainElement(HaveField("Field", "locators[0].urls")))
+	})
+
+	It("should fail on unrepresentable values unless lenient", func() {
+		record.Locators[0].Urls = append(record.Locators[0].Urls, "docker.io/agntcy/agent")
+		record.Skills[0].Annotations = map[string]string{"k": "v"}
+
+		_, report, err := migrate.V1ToV1alpha2(record)
+		var unrepresentable *migrate.UnrepresentableError
+		Expect(errors.As(err, &unrepresentable)).To(BeTrue())
+		Expect(unrepresentable.To).To(Equal(migrate.V1alpha2))
+		Expect(unrepresentable.Fields).To(Equal([]string{"locators[0].urls[1]", "skills[0].annotations"}))
+		Expect(err).To(MatchError("v1alpha2 cannot represent locators[0].urls[1], skills[0].annotations"))
+		Expect(report.Dropped()).To(HaveLen(3))
+
+		downgraded, report, err := migrate.V1ToV1alpha2(record, migrate.Lenient())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(downgraded.GetLocators()[0].GetUrl()).To(Equal("ghcr.io/agntcy/agent"))
+		Expect(report.Warnings()).To(HaveLen(3))
+	})
+
+	It("should downgrade to v1alpha1", func() {
+		downgraded, reports, err := migrate.FromV1(record, migrate.V1alpha1)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(reports).To(HaveLen(2))
+		Expect(reports[1].Changes).To(BeEmpty())
+
+		v1alpha1, ok := downgraded.(*typesv1alpha1.Record)
+		Expect(ok).To(BeTrue())
+		Expect(v1alpha1.GetName()).To(Equal("agent"))
+		Expect(v1alpha1.GetSkills()[0].GetId()).To(BeEquivalentTo(10201))
+		Expect(v1alpha1.GetModules()[0].GetName()).To(Equal("integration/a2a"))
+		Expect(v1alpha1.GetLocators()[0].GetType()).To(Equal("docker_image"))
+	})
+
+	It("should keep skill and domain annotations past v1alpha2", func() {
+		record.Skills[0].Annotations = map[string]string{"k": "v"}
+		record.Domains = []*typesv1.Domain{{Name: "technology", Id: 1, Annotations: map[string]string{"l": "w"}}}
+
+		downgraded, reports, err := migrate.FromV1(record, migrate.V1alpha1)
+		Expect(err).NotTo(HaveOccurred())
+		v1alpha1 := downgraded.(*typesv1alpha1.Record)
+		Expect(v1alpha1.GetSkills()[0].GetAnnotations()).To(Equal(map[string]string{"k": "v"}))
+		Expect(v1alpha1.GetDomains()[0].GetAnnotations()).To(Equal(map[string]string{"l": "w"}))
+		Expect(reports[0].Dropped()).To(ConsistOf(HaveField("Field", "modules[0].artifact")))
+
+		record.Domains = nil
+		downgraded, _, err = migrate.FromV1(record, migrate.V1alpha0, migrate.Lenient())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(downgraded.(*typesv1alpha0.Record).GetSkills()[0].GetAnnotations()).To(Equal(map[string]string{"k": "v"}))
+
+		_, _, err = migrate.FromV1(record, migrate.V1alpha2)
+		Expect(err).To(MatchError("v1alpha2 cannot represent skills[0].annotations"))
+	})
+
+	It("should report locator types the alpha versions do not define", func() {
+		record.Locators = []*typesv1.Locator{
+			{Type: "package", Urls: []string{"pypi.org/agent"}},
+			{Type: "unspecified", Urls: []string{"example.com/agent"}},
+			{Type: "url", Urls: []string{"https://agent.example.com"}},
+		}
+
+		_, _, err := migrate.V1ToV1alpha2(record)
+		Expect(err).To(MatchError("v1alpha2 cannot represent locators[2].type"))
+
+		downgraded, report, err := migrate.V1ToV1alpha2(record, migrate.Lenient())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(downgraded.GetLocators()[0].GetType()).To(Equal("python_package"))
+		Expect(downgraded.GetLocators()[1].GetType()).To(BeEmpty())
+		Expect(downgraded.GetLocators()[2].GetType()).To(BeEmpty())
+		Expect(downgraded.GetLocators()[2].GetUrl()).To(Equal("https://agent.example.com"))
+		Expect(changes(report)).To(ContainElements(
+			`locators[0].type: converted: "package" became "python_package", assuming a Python package`,
+			`locators[1].type: converted: "unspecified" became ""`,
+			`locators[2].type: dropped: locator type "url" is not defined in v1alpha2`,
+		))
+	})
+
+	It("should round trip unspecified locator types through v1alpha0", func() {
+		record.Locators[0].Type = "unspecified"
+		record.Modules = nil
+		downgraded, _, err := migrate.FromV1(record, migrate.V1alpha0)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(downgraded.(*typesv1alpha0.Record).GetLocators()[0].GetType()).To(BeEmpty())
+
+		upgraded, reports, err := migrate.ToV1(downgraded)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(upgraded.GetLocators()[0].GetType()).To(Equal("unspecified"))
+		Expect(changes(reports[2])).To(ContainElement(`locators[0].type: converted: "" became "unspecified"`))
+	})
+
+	It("should map modules to extensions in v1alpha0", func() {
+		_, reports, err := migrate.FromV1(record, migrate.V1alpha0)
+		Expect(err).To(MatchError("v1alpha0 cannot represent modules[0].id"))
+		Expect(reports).To(HaveLen(3))
+
+		downgraded, reports, err := migrate.FromV1(record, migrate.V1alpha0, migrate.Lenient())
+		Expect(err).NotTo(HaveOccurred())
+		v1alpha0, ok := downgraded.(*typesv1alpha0.Record)
+		Expect(ok).To(BeTrue())
+		Expect(v1alpha0.GetExtensions()).To(HaveLen(1))
+		Expect(v1alpha0.GetExtensions()[0].GetName()).To(Equal("integration/a2a"))
+		Expect(v1alpha0.GetExtensions()[0].GetData().AsMap()).To(HaveKeyWithValue("protocol_version", "0.3.0"))
+		Expect(v1alpha0.GetSkills()[0].GetCategoryName()).To(Equal("natural_language_processing"))
+		Expect(v1alpha0.GetSkills()[0].GetClassName()).To(Equal("text_completion"))
+		Expect(v1alpha0.GetSkills()[0].GetClassUid()).To(BeEquivalentTo(10201))
+		Expect(reports[2].Warnings()).To(ConsistOf(
+			"modules[0].id: dropped: extensions have no id in v1alpha0, 203 was dropped",
+		))
+	})
+
+	It("should round trip through an upgrade", func() {
+		record.Modules[0].Artifact = nil
+		downgraded, _, err := migrate.V1ToV1alpha2(record)
+		Expect(err).NotTo(HaveOccurred())
+		upgraded, report := migrate.V1alpha2ToV1(downgraded)
+		Expect(report.Lossy()).To(BeFalse())
+		Expect(proto.Equal(upgraded, record)).To(BeTrue())
+	})
+
+	It("should reject unknown versions", func() {
+		_, _, err := migrate.FromV1(record, "v2")
+		Expect(err).To(MatchError(`unsupported version "v2"`))
+
+		same, reports, err := migrate.FromV1(record, migrate.V1)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(same).To(BeIdenticalTo(record))
+		Expect(reports).To(BeEmpty())
+
+		_, _, err = migrate.V1alpha2ToV1alpha1(&typesv1alpha2.Record{})
+		Expect(err).NotTo(HaveOccurred())
+	})
+})
@@ -0,0 +1,179 @@
+// Package migrate converts records between the OASF proto versions
+// v1alpha0, v1alpha1, v1alpha2 and v1.
+//
+// Every conversion returns a Report listing the fields whose value was dropped
+// or converted, and the populated fields that moved to another field number.
+// Skill, domain and module names and ids, as well as the schema_version, are
+// carried over unchanged; they are not remapped between schema versions.
+//
+// Upgrades always succeed. Downgrades fail when a value cannot be represented
+// by the older version, unless the Lenient option is given.
+package migrate
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// ChangeKind classifies a change made while converting a record.
+type ChangeKind string
+
+const (
+	// Dropped marks a value that the target version cannot hold.
+	Dropped ChangeKind = "dropped"
+	// Converted marks a value that was transformed to fit the target version.
+	Converted ChangeKind = "converted"
+	// Renumbered marks a field that kept its value under another field number.
+	Renumbered ChangeKind = "renumbered"
+)
+
+// Change is a single difference between a record and its conversion.
+type Change struct {
+	// Field is the path of the field in the source record, e.g. skills[0].annotations.
+	Field   string     `json:"field"`
+	Kind    ChangeKind `json:"kind"`
+	Message string     `json:"message"`
+}
+
+func (c Change) String() string {
+	return fmt.Sprintf("%s: %s: %s", c.Field, c.Kind, c.Message)
+}
+
+// Report lists the changes made by a conversion between two versions.
+type Report struct {
+	From    string   `json:"from"`
+	To      string   `json:"to"`
+	Changes []Change `json:"changes"`
+}
+
+// Lossy reports whether any value was dropped.
+func (r *Report) Lossy() bool {
+	for _, change := range r.Changes {
+		if change.Kind == Dropped {
+			return true
+		}
+	}
+	return false
+}
+
+// Warnings returns the dropped values as human readable messages.
+func (r *Report) Warnings() []string {
+	var warnings []string
+	for _, change := range r.Dropped() {
+		warnings = append(warnings, change.String())
+	}
+	return warnings
+}
+
+// Dropped returns the changes of kind Dropped.
+func (r *Report) Dropped() []Change {
+	var dropped []Change
+	for _, change := range r.Changes {
+		if change.Kind == Dropped {
+			dropped = append(dropped, change)
+		}
+	}
+	return dropped
+}
+
+func (r *Report) add(kind ChangeKind, field, format string, args ...any) {
+	r.Changes = append(r.Changes, Change{Field: field, Kind: kind, Message: fmt.Sprintf(format, args...)})
+}
+
+// renumbered reports the populated fields of src whose counterpart of the same
+// name in dst has another field number. Nested messages are compared element
+// by element, but each field is reported once regardless of list indices.
+func (r *Report) renumbered(path string, src, dst protoreflect.Message) {
+	seen := make(map[string]bool)
+	for _, change := range r.Changes {
+		if change.Kind == Renumbered {
+			seen[change.Field] = true
+		}
+	}
+	r.compareNumbers(path, src, dst, seen)
+}
+
+func (r *Report) compareNumbers(path string, src, dst protoreflect.Message, seen map[string]bool) {
+	fields := src.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		target := dst.Descriptor().Fields().ByName(field.Name())
+		if !src.Has(field) || target == nil {
+			continue
+		}
+		name := join(path, string(field.Name()))
+		if field.Number() != target.Number() && !seen[name] {
+			seen[name] = true
+			r.add(Renumbered, name, "field number %d became %d", field.Number(), target.Number())
+		}
+		if field.Message() == nil || target.Message() == nil || field.IsMap() || !dst.Has(target) {
+			continue
+		}
+		switch {
+		case field.IsList() && target.IsList():
+			srcList, dstList := src.Get(field).List(), dst.Get(target).List()
+			for j := 0; j < srcList.Len() && j < dstList.Len(); j++ {
+				r.compareNumbers(name, srcList.Get(j).Message(), dstList.Get(j).Message(), seen)
+			}
+		case !field.IsList() && !target.IsList():
+			r.compareNumbers(name, src.Get(field).Message(), dst.Get(target).Message(), seen)
+		}
+	}
+}
+
+func join(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+func index(path string, i int) string {
+	return fmt.Sprintf("%s[%d]", path, i)
+}
+
+// locatorTypes maps the locator types of the alpha versions to the ones of v1.
+// The empty alpha type is the unspecified one.
+var locatorTypes = map[string]string{
+	"":               "unspecified",
+	"docker_image":   "container_image",
+	"python_package": "package",
+}
+
+// alphaLocatorTypes are the locator types the alpha versions define.
+var alphaLocatorTypes = []string{"", "helm_chart", "docker_image", "python_package", "source_code", "binary"}
+
+func upgradeLocatorType(value string) string {
+	if upgraded, ok := locatorTypes[value]; ok {
+		return upgraded
+	}
+	return value
+}
+
+func downgradeLocatorType(value string) string {
+	for alpha, upgraded := range locatorTypes {
+		if upgraded == value {
+			return alpha
+		}
+	}
+	return value
+}
+
+// isClassAnnotations reports whether a field is the annotations of a skill or
+// domain, e.g. skills[0].annotations.
+func isClassAnnotations(field string) bool {
+	return (strings.HasPrefix(field, "skills[") || strings.HasPrefix(field, "domains[")) &&
+		strings.HasSuffix(field, "].annotations")
+}
+
+func skillName(category, class string) string {
+	var parts []string
+	for _, part := range []string{category, class} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, "/")
+}
@@ -0,0 +1,246 @@
+package migrate
+
+import (
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+
+	typesv1 "github.com/agntcy/oasf/proto/go/agntcy/oasf/types/v1"
+	typesv1alpha0 "github.com/agntcy/oasf/proto/go/agntcy/oasf/types/v1alpha0"
+	typesv1alpha1 "github.com/agntcy/oasf/proto/go/agntcy/oasf/types/v1alpha1"
+	typesv1alpha2 "github.com/agntcy/oasf/proto/go/agntcy/oasf/types/v1alpha2"
+	"google.golang.org/protobuf/proto"
+)
+
+// Version names, as used in reports.
+const (
+	V1alpha0 = "v1alpha0"
+	V1alpha1 = "v1alpha1"
+	V1alpha2 = "v1alpha2"
+	V1       = "v1"
+)
+
+// ToV1 upgrades a record of any supported version to v1, one version at a
+// time, and returns the report of every step taken. Skill and domain
+// annotations, which v1alpha2 cannot hold, are carried over to v1 and are not
+// reported as dropped. A v1 record is returned as is with no report.
+func ToV1(record proto.Message) (*typesv1.Record, []*Report, error) {
+	var reports []*Report
+	switch r := record.(type) {
+	case *typesv1.Record:
+		return r, nil, nil
+	case *typesv1alpha2.Record:
+		upgraded, report := V1alpha2ToV1(r)
+		return upgraded, append(reports, report), nil
+	case *typesv1alpha1.Record:
+		upgraded, stepReports := v1alpha1ToV1(r)
+		return upgraded, append(reports, stepReports...), nil
+	case *typesv1alpha0.Record:
+		v1alpha1, report := V1alpha0ToV1alpha1(r)
+		reports = append(reports, report)
+		upgraded, stepReports := v1alpha1ToV1(v1alpha1)
+		return upgraded, append(reports, stepReports...), nil
+	}
+	return nil, nil, fmt.Errorf("unsupported record type %T", record)
+}
+
+// v1alpha1ToV1 upgrades a v1alpha1 record through v1alpha2, restoring the
+// skill and domain annotations v1alpha2 drops.
+func v1alpha1ToV1(record *typesv1alpha1.Record) (*typesv1.Record, []*Report) {
+	v1alpha2, report := V1alpha1ToV1alpha2(record)
+	upgraded, v1Report := V1alpha2ToV1(v1alpha2)
+	for i, skill := range record.GetSkills() {
+		upgraded.Skills[i].Annotations = maps.Clone(skill.GetAnnotations())
+	}
+	for i, domain := range record.GetDomains() {
+		upgraded.Domains[i].Annotations = maps.Clone(domain.GetAnnotations())
+	}
+	report.Changes = slices.DeleteFunc(report.Changes, func(change Change) bool {
+		return change.Kind == Dropped && isClassAnnotations(change.Field)
+	})
+	return upgraded, []*Report{report, v1Report}
+}
+
+// V1alpha0ToV1alpha1 upgrades a v1alpha0 record. Skills are identified by the
+// joined category and class names and by the class uid; category uids are
+// dropped. Extensions become modules without an id, losing their version.
+func V1alpha0ToV1alpha1(record *typesv1alpha0.Record) (*typesv1alpha1.Record, *Report) {
+	report := &Report{From: V1alpha0, To: V1alpha1}
+	upgraded := &typesv1alpha1.Record{
+		Name:          record.GetName(),
+		Version:       record.GetVersion(),
+		SchemaVersion: record.GetSchemaVersion(),
+		Description:   record.GetDescription(),
+		Authors:       slices.Clone(record.GetAuthors()),
+		Annotations:   maps.Clone(record.GetAnnotations()),
+		CreatedAt:     record.GetCreatedAt(),
+	}
+
+	for i, skill := range record.GetSkills() {
+		field := index("skills", i)
+		upgraded.Skills = append(upgraded.Skills, &typesv1alpha1.Skill{
+			Name:        skillName(skill.GetCategoryName(), skill.GetClassName()),
+			Id:          uint32(min(skill.GetClassUid(), math.MaxUint32)),
+			Annotations: maps.Clone(skill.GetAnnotations()),
+		})
+		if skill.CategoryName != nil && skill.ClassName != nil {
+			report.add(Converted, join(field, "category_name"), "joined with class_name into name")
+		}
+		if skill.GetClassUid() > math.MaxUint32 {
+			report.add(Dropped, join(field, "class_uid"), "%d does not fit the 32-bit id", skill.GetClassUid())
+		} else if skill.GetClassUid() != 0 {
+			report.add(Converted, join(field, "class_uid"), "became id")
+		}
+		if skill.GetCategoryUid() != 0 {
+			report.add(Dropped, join(field, "category_uid"), "%d has no counterpart, the class uid identifies the skill", skill.GetCategoryUid())
+		}
+	}
+
+	for _, locator := range record.GetLocators() {
+		upgraded.Locators = append(upgraded.Locators, &typesv1alpha1.Locator{
+			Type:        locator.GetType(),
+			Url:         locator.GetUrl(),
+			Annotations: maps.Clone(locator.GetAnnotations()),
+			Size:        locator.Size,
+			Digest:      locator.Digest,
+		})
+	}
+
+	for i, extension := range record.GetExtensions() {
+		field := index("extensions", i)
+		upgraded.Modules = append(upgraded.Modules, &typesv1alpha1.Module{
+			Name:        extension.GetName(),
+			Annotations: maps.Clone(extension.GetAnnotations()),
+			Data:        proto.CloneOf(extension.GetData()),
+		})
+		report.add(Converted, field, "became module %q without an id", extension.GetName())
+		if extension.GetVersion() != "" {
+			report.add(Dropped, join(field, "version"), "modules are not versioned, %q was dropped", extension.GetVersion())
+		}
+	}
+
+	if signature := record.GetSignature(); signature != nil {
+		upgraded.Signature = &typesv1alpha1.Signature{
+			SignedAt:      signature.GetSignedAt(),
+			Algorithm:     signature.GetAlgorithm(),
+			Signature:     signature.GetSignature(),
+			Certificate:   signature.GetCertificate(),
+			ContentType:   signature.GetContentType(),
+			ContentBundle: signature.GetContentBundle(),
+		}
+	}
+
+	report.renumbered("", record.ProtoReflect(), upgraded.ProtoReflect())
+	return upgraded, report
+}
+
+// V1alpha1ToV1alpha2 upgrades a v1alpha1 record, dropping the signature and the
+// annotations of skills and domains.
+func V1alpha1ToV1alpha2(record *typesv1alpha1.Record) (*typesv1alpha2.Record, *Report) {
+	report := &Report{From: V1alpha1, To: V1alpha2}
+	upgraded := &typesv1alpha2.Record{
+		Name:              record.GetName(),
+		Version:           record.GetVersion(),
+		SchemaVersion:     record.GetSchemaVersion(),
+		Description:       record.GetDescription(),
+		Authors:           slices.Clone(record.GetAuthors()),
+		Annotations:       maps.Clone(record.GetAnnotations()),
+		CreatedAt:         record.GetCreatedAt(),
+		PreviousRecordCid: record.PreviousRecordCid,
+	}
+
+	for _, locator := range record.GetLocators() {
+		upgraded.Locators = append(upgraded.Locators, &typesv1alpha2.Locator{
+			Type:        locator.GetType(),
+			Url:         locator.GetUrl(),
+			Annotations: maps.Clone(locator.GetAnnotations()),
+			Size:        locator.Size,
+			Digest:      locator.Digest,
+		})
+	}
+	for i, skill := range record.GetSkills() {
+		upgraded.Skills = append(upgraded.Skills, &typesv1alpha2.Skill{Name: skill.GetName(), Id: skill.GetId()})
+		if len(skill.GetAnnotations()) > 0 {
+			report.add(Dropped, join(index("skills", i), "annotations"), "skills have no annotations in %s", V1alpha2)
+		}
+	}
+	for i, domain := range record.GetDomains() {
+		upgraded.Domains = append(upgraded.Domains, &typesv1alpha2.Domain{Name: domain.GetName(), Id: domain.GetId()})
+		if len(domain.GetAnnotations()) > 0 {
+			report.add(Dropped, join(index("domains", i), "annotations"), "domains have no annotations in %s", V1alpha2)
+		}
+	}
+	for _, module := range record.GetModules() {
+		upgraded.Modules = append(upgraded.Modules, &typesv1alpha2.Module{
+			Name:        module.GetName(),
+			Id:          module.GetId(),
+			Annotations: maps.Clone(module.GetAnnotations()),
+			Data:        proto.CloneOf(module.GetData()),
+		})
+	}
+	if record.GetSignature() != nil {
+		report.add(Dropped, "signature", "records are not signed inline in %s", V1alpha2)
+	}
+
+	report.renumbered("", record.ProtoReflect(), upgraded.ProtoReflect())
+	return upgraded, report
+}
+
+// V1alpha2ToV1 upgrades a v1alpha2 record. Locator urls become single-element
+// url lists and alpha locator types are renamed; locator sizes and digests as
+// well as the previous record CID are dropped.
+func V1alpha2ToV1(record *typesv1alpha2.Record) (*typesv1.Record, *Report) {
+	report := &Report{From: V1alpha2, To: V1}
+	upgraded := &typesv1.Record{
+		Annotations:   maps.Clone(record.GetAnnotations()),
+		Name:          record.GetName(),
+		Version:       record.GetVersion(),
+		SchemaVersion: record.GetSchemaVersion(),
+		Description:   record.GetDescription(),
+		Authors:       slices.Clone(record.GetAuthors()),
+		CreatedAt:     record.GetCreatedAt(),
+	}
+
+	for i, locator := range record.GetLocators() {
+		field := index("locators", i)
+		converted := &typesv1.Locator{
+			Annotations: maps.Clone(locator.GetAnnotations()),
+			Type:        upgradeLocatorType(locator.GetType()),
+		}
+		if locator.GetType() != converted.GetType() {
+			report.add(Converted, join(field, "type"), "%q became %q", locator.GetType(), converted.GetType())
+		}
+		if locator.GetUrl() != "" {
+			converted.Urls = []string{locator.GetUrl()}
+			report.add(Converted, join(field, "url"), "became urls")
+		}
+		if locator.Size != nil {
+			report.add(Dropped, join(field, "size"), "locators have no size in %s", V1)
+		}
+		if locator.Digest != nil {
+			report.add(Dropped, join(field, "digest"), "locators have no digest in %s", V1)
+		}
+		upgraded.Locators = append(upgraded.Locators, converted)
+	}
+	for _, skill := range record.GetSkills() {
+		upgraded.Skills = append(upgraded.Skills, &typesv1.Skill{Name: skill.GetName(), Id: skill.GetId()})
+	}
+	for _, domain := range record.GetDomains() {
+		upgraded.Domains = append(upgraded.Domains, &typesv1.Domain{Name: domain.GetName(), Id: domain.GetId()})
+	}
+	for _, module := range record.GetModules() {
+		upgraded.Modules = append(upgraded.Modules, &typesv1.Module{
+			Annotations: maps.Clone(module.GetAnnotations()),
+			Name:        module.GetName(),
+			Id:          module.GetId(),
+			Data:        proto.CloneOf(module.GetData()),
+		})
+	}
+	if record.PreviousRecordCid != nil {
+		report.add(Dropped, "previous_record_cid", "records are not linked in %s", V1)
+	}
+
+	report.renumbered("", record.ProtoReflect(), upgraded.ProtoReflect())
+	return upgraded, report
+}
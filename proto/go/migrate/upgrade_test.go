@@ -0,0 +1,194 @@
+package migrate_test
+
+import (
+	typesv1 "github.com/agntcy/oasf/proto/go/agntcy/oasf/types/v1"
+	typesv1alpha0 "github.com/agntcy/oasf/proto/go/agntcy/oasf/types/v1alpha0"
+	typesv1alpha1 "github.com/agntcy/oasf/proto/go/agntcy/oasf/types/v1alpha1"
+	typesv1alpha2 "github.com/agntcy/oasf/proto/go/agntcy/oasf/types/v1alpha2"
+	"github.com/agntcy/oasf/proto/go/migrate"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func changes(report *migrate.Report) []string {
+	var result []string
+	for _, change := range report.Changes {
+		result = append(result, change.String())
+	}
+	return result
+}
+
+var _ = Describe("Upgrade", func() {
+	var data *structpb.Struct
+
+	BeforeEach(func() {
+		var err error
+		data, err = structpb.NewStruct(map[string]any{"protocol_version": "0.3.0"})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should upgrade a v1alpha0 record to v1alpha1", func() {
+		record := &typesv1alpha0.Record{
+			SchemaVersion: "v0.3.1",
+			Name:          "agent",
+			Version:       "v1.0.0",
+			Authors:       []string{"AGNTCY"},
+			Skills: []*typesv1alpha0.Skill{{
+				Annotations:  map[string]string{"source": "manual"},
+				CategoryUid:  1,
+				ClassUid:     10101,
+				CategoryName: proto.String("Natural Language Processing"),
+				ClassName:    proto.String("Contextual Comprehension"),
+			}},
+			Locators: []*typesv1alpha0.Locator{{Type: "docker_image", Url: "ghcr.io/agntcy/agent"}},
+			Extensions: []*typesv1alpha0.Extension{{
+				Name:    "schema.oasf.agntcy.org/features/runtime/a2a",
+				Version: "v0.0.0",
+				Data:    data,
+			}},
+			Signature: &typesv1alpha0.Signature{Algorithm: "ECDSA", Signature: "c2ln", SignedAt: "2025-01-01T00:00:00Z"},
+		}
+
+		upgraded, report := migrate.V1alpha0ToV1alpha1(record)
+		Expect(upgraded.GetName()).To(Equal("agent"))
+		Expect(upgraded.GetSchemaVersion()).To(Equal("v0.3.1"))
+		Expect(upgraded.GetSkills()).To(HaveLen(1))
+		Expect(upgraded.GetSkills()[0].GetName()).To(Equal("Natural Language Processing/Contextual Comprehension"))
+		Expect(upgraded.GetSkills()[0].GetId()).To(BeEquivalentTo(10101))
+		Expect(upgraded.GetSkills()[0].GetAnnotations()).To(HaveKeyWithValue("source", "manual"))
+		Expect(upgraded.GetModules()).To(HaveLen(1))
+		Expect(upgraded.GetModules()[0].GetName()).To(Equal("schema.oasf.agntcy.org/features/runtime/a2a"))
+		Expect(proto.Equal(upgraded.GetModules()[0].GetData(), data)).To(BeTrue())
+		Expect(upgraded.GetSignature().GetAlgorithm()).To(Equal("ECDSA"))
+		Expect(upgraded.GetSignature().GetSignedAt()).To(Equal("2025-01-01T00:00:00Z"))
+
+		Expect(report.From).To(Equal(migrate.V1alpha0))
+		Expect(report.To).To(Equal(migrate.V1alpha1))
+		Expect(report.Lossy()).To(BeTrue())
+		Expect(changes(report)).To(ContainElements(
+			"skills[0].category_name: converted: joined with class_name into name",
+			"skills[0].class_uid: converted: became id",
+			"skills[0].category_uid: dropped: 1 has no counterpart, the class uid identifies the skill",
+			`extensions[0]: converted: became module "schema.oasf.agntcy.org/features/runtime/a2a" without an id`,
+			`extensions[0].version: dropped: modules are not versioned, "v0.0.0" was dropped`,
+			"schema_version: renumbered: field number 1 became 3",
+			"name: renumbered: field number 2 became 1",
+			"skills.annotations: renumbered: field number 1 became 3",
+			"signature: renumbered: field number 11 became 12",
+			"signature.algorithm: renumbered: field number 1 became 3",
+		))
+		Expect(changes(report)).NotTo(ContainElement(HavePrefix("description")))
+	})
+
+	It("should report the signature and class annotations dropped from v1alpha1", func() {
+		record := &typesv1alpha1.Record{
+			Name:              "agent",
+			Skills:            []*typesv1alpha1.Skill{{Name: "a", Id: 1, Annotations: map[string]string{"k": "v"}}},
+			Domains:           []*typesv1alpha1.Domain{{Name: "b", Id: 2}},
+			Modules:           []*typesv1alpha1.Module{{Name: "integration/a2a", Id: 203, Data: data}},
+			Signature:         &typesv1alpha1.Signature{Signature: "c2ln"},
+			PreviousRecordCid: proto.String("bafy"),
+		}
+
+		upgraded, report := migrate.V1alpha1ToV1alpha2(record)
+		Expect(upgraded.GetSkills()[0].GetName()).To(Equal("a"))
+		Expect(upgraded.GetDomains()[0].GetId()).To(BeEquivalentTo(2))
+		Expect(upgraded.GetModules()[0].GetId()).To(BeEquivalentTo(203))
+		Expect(upgraded.GetPreviousRecordCid()).To(Equal("bafy"))
+		Expect(changes(report)).To(ConsistOf(
+			"skills[0].annotations: dropped: skills have no annotations in v1alpha2",
+			"signature: dropped: records are not signed inline in v1alpha2",
+		))
+	})
+
+	It("should upgrade v1alpha2 locators and report the renumbered fields", func() {
+		record := &typesv1alpha2.Record{
+			Name:    "agent",
+			Version: "v1.0.0",
+			Locators: []*typesv1alpha2.Locator{
+				{Type: "docker_image", Url: "ghcr.io/agntcy/agent", Size: proto.Uint64(10), Digest: proto.String("sha256:00")},
+				{Type: "source_code", Url: "https://github.com/agntcy/agent"},
+			},
+			Skills:  []*typesv1alpha2.Skill{{Name: "a", Id: 1}},
+			Modules: []*typesv1alpha2.Module{{Name: "integration/a2a", Id: 203, Annotations: map[string]string{"k": "v"}}},
+		}
+
+		upgraded, report := migrate.V1alpha2ToV1(record)
+		Expect(upgraded.GetLocators()).To(HaveLen(2))
+		Expect(upgraded.GetLocators()[0].GetType()).To(Equal("container_image"))
+		Expect(upgraded.GetLocators()[0].GetUrls()).To(Equal([]string{"ghcr.io/agntcy/agent"}))
+		Expect(upgraded.GetLocators()[1].GetType()).To(Equal("source_code"))
+		Expect(upgraded.GetModules()[0].GetAnnotations()).To(HaveKeyWithValue("k", "v"))
+		Expect(changes(report)).To(ConsistOf(
+			`locators[0].type: converted: "docker_image" became "container_image"`,
+			"locators[0].url: converted: became urls",
+			"locators[0].size: dropped: locators have no size in v1",
+			"locators[0].digest: dropped: locators have no digest in v1",
+			"locators[1].url: converted: became urls",
+			"name: renumbered: field number 1 became 2",
+			"version: renumbered: field number 2 became 3",
+			"locators.type: renumbered: field number 1 became 2",
+			"skills.name: renumbered: field number 1 became 2",
+			"skills.id: renumbered: field number 2 became 3",
+			"modules.name: renumbered: field number 1 became 2",
+			"modules.id: renumbered: field number 2 became 3",
+			"modules.annotations: renumbered: field number 3 became 1",
+		))
+	})
+
+	It("should chain the upgrades to v1", func() {
+		record := &typesv1alpha0.Record{
+			Name:       "agent",
+			Locators:   []*typesv1alpha0.Locator{{Type: "python_package", Url: "pypi.org/agent"}},
+			Extensions: []*typesv1alpha0.Extension{{Name: "runtime/a2a", Data: data}},
+			Signature:  &typesv1alpha0.Signature{Signature: "c2ln"},
+		}
+
+		upgraded, reports, err := migrate.ToV1(record)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(reports).To(HaveLen(3))
+		Expect(reports[0].To).To(Equal(migrate.V1alpha1))
+		Expect(reports[2].To).To(Equal(migrate.V1))
+		Expect(reports[1].Dropped()).To(HaveLen(1))
+		Expect(reports[1].Dropped()[0].Field).To(Equal("signature"))
+		Expect(upgraded.GetLocators()[0].GetType()).To(Equal("package"))
+		Expect(upgraded.GetLocators()[0].GetUrls()).To(Equal([]string{"pypi.org/agent"}))
+		Expect(upgraded.GetModules()[0].GetName()).To(Equal("runtime/a2a"))
+		Expect(proto.Equal(upgraded.GetModules()[0].GetData(), data)).To(BeTrue())
+
+		current := &typesv1.Record{Name: "agent"}
+		same, reports, err := migrate.ToV1(current)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(same).To(BeIdenticalTo(current))
+		Expect(reports).To(BeEmpty())
+
+		_, _, err = migrate.ToV1(data)
+		Expect(err).To(MatchError(ContainSubstring("unsupported record type")))
+	})
+
+	It("should keep skill and domain annotations on the way to v1", func() {
+		record := &typesv1alpha1.Record{
+			Name:    "agent",
+			Skills:  []*typesv1alpha1.Skill{{Name: "a", Id: 1, Annotations: map[string]string{"k": "v"}}},
+			Domains: []*typesv1alpha1.Domain{{Name: "b", Id: 2, Annotations: map[string]string{"l": "w"}}},
+		}
+
+		upgraded, reports, err := migrate.ToV1(record)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(upgraded.GetSkills()[0].GetAnnotations()).To(Equal(map[string]string{"k": "v"}))
+		Expect(upgraded.GetDomains()[0].GetAnnotations()).To(Equal(map[string]string{"l": "w"}))
+		Expect(reports).To(HaveLen(2))
+		Expect(reports[0].Lossy()).To(BeFalse())
+		Expect(reports[1].Lossy()).To(BeFalse())
+
+		upgraded, reports, err = migrate.ToV1(&typesv1alpha0.Record{
+			Name:   "agent",
+			Skills: []*typesv1alpha0.Skill{{ClassName: proto.String("a"), Annotations: map[string]string{"k": "v"}}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(upgraded.GetSkills()[0].GetAnnotations()).To(Equal(map[string]string{"k": "v"}))
+		Expect(reports[1].Dropped()).To(BeEmpty())
+	})
+})
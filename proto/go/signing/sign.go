@@ -0,0 +1,156 @@
+// Package signing signs records and verifies their signatures against a trust
+// store.
+//
+// A signature covers the sha256 digest of the canonical form of a record (see
+// the canonical package), computed with the record signature field cleared.
+// ECDSA P-256 keys sign the digest as a SHA-256 hash; Ed25519 keys sign the
+// digest bytes as the message.
+package signing
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	typesv1alpha1 "github.com/agntcy/oasf/proto/go/agntcy/oasf/types/v1alpha1"
+	"github.com/agntcy/oasf/proto/go/canonical"
+	"google.golang.org/protobuf/proto"
+)
+
+// Signature algorithms.
+const (
+	AlgorithmECDSAP256SHA256 = "ECDSA_P256_SHA256"
+	AlgorithmEd25519         = "ED25519"
+)
+
+// ContentTypeDigest is the content type of signatures whose content bundle
+// holds the signed record digest.
+const ContentTypeDigest = "application/vnd.oasf.record.digest"
+
+// Option configures a Signer.
+type Option func(*Signer)
+
+// WithCertificate embeds the certificate of the signing key in signatures,
+// instead of its bare public key.
+func WithCertificate(certificate *x509.Certificate) Option {
+	return func(s *Signer) {
+		s.certificate = certificate
+	}
+}
+
+// WithClock sets the function returning the signing time. It defaults to
+// time.Now.
+func WithClock(now func() time.Time) Option {
+	return func(s *Signer) {
+		s.now = now
+	}
+}
+
+// Signer signs records with an ECDSA P-256 or Ed25519 key.
+type Signer struct {
+	key         crypto.Signer
+	algorithm   string
+	certificate *x509.Certificate
+	now         func() time.Time
+}
+
+// NewSigner returns a signer for the key, which may also be backed by a
+// hardware or remote key implementing crypto.Signer.
+func NewSigner(key crypto.Signer, opts ...Option) (*Signer, error) {
+	algorithm, err := algorithmOf(key.Public())
+	if err != nil {
+		return nil, err
+	}
+	s := &Signer{key: key, algorithm: algorithm, now: time.Now}
+	for _, opt := range opts {
+		opt(s)
+	}
+	if s.certificate != nil && !publicKeysEqual(s.certificate.PublicKey, key.Public()) {
+		return nil, fmt.Errorf("certificate does not match the signing key")
+	}
+	return s, nil
+}
+
+// Sign signs a record and returns its signature.
+func (s *Signer) Sign(record proto.Message) (*typesv1alpha1.Signature, error) {
+	digest, err := Digest(record)
+	if err != nil {
+		return nil, err
+	}
+	sum, err := hex.DecodeString(strings.TrimPrefix(digest, "sha256:"))
+	if err != nil {
+		return nil, err
+	}
+	opts := crypto.SignerOpts(crypto.SHA256)
+	if s.algorithm == AlgorithmEd25519 {
+		opts = crypto.Hash(0)
+	}
+	signature, err := s.key.Sign(rand.Reader, sum, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign record: %w", err)
+	}
+
+	var certificate []byte
+	if s.certificate != nil {
+		certificate = s.certificate.Raw
+	} else if certificate, err = x509.MarshalPKIXPublicKey(s.key.Public()); err != nil {
+		return nil, err
+	}
+	return &typesv1alpha1.Signature{
+		SignedAt:      s.now().UTC().Format(time.RFC3339),
+		Algorithm:     s.algorithm,
+		Signature:     base64.StdEncoding.EncodeToString(signature),
+		Certificate:   base64.StdEncoding.EncodeToString(certificate),
+		ContentType:   ContentTypeDigest,
+		ContentBundle: base64.StdEncoding.EncodeToString([]byte(digest)),
+	}, nil
+}
+
+// SignRecord signs a record and sets its signature. Any previous signature is
+// replaced.
+func (s *Signer) SignRecord(record *typesv1alpha1.Record) error {
+	signature, err := s.Sign(record)
+	if err != nil {
+		return err
+	}
+	record.Signature = signature
+	return nil
+}
+
+// Digest returns the digest a signature of the record covers: the canonical
+// digest of the record without its signature field.
+func Digest(record proto.Message) (string, error) {
+	fields := record.ProtoReflect().Descriptor().Fields()
+	if field := fields.ByName("signature"); field != nil && record.ProtoReflect().Has(field) {
+		record = proto.Clone(record)
+		record.ProtoReflect().Clear(field)
+	}
+	return canonical.Digest(record)
+}
+
+func algorithmOf(key crypto.PublicKey) (string, error) {
+	switch key := key.(type) {
+	case *ecdsa.PublicKey:
+		if key.Curve != elliptic.P256() {
+			return "", fmt.Errorf("unsupported ECDSA curve %s, expected P-256", key.Curve.Params().Name)
+		}
+		return AlgorithmECDSAP256SHA256, nil
+	case ed25519.PublicKey:
+		return AlgorithmEd25519, nil
+	default:
+		return "", fmt.Errorf("unsupported key type %T, expected an ECDSA P-256 or Ed25519 key", key)
+	}
+}
+
+func publicKeysEqual(a, b crypto.PublicKey) bool {
+	key, ok := a.(interface{ Equal(crypto.PublicKey) bool })
+	return ok && key.Equal(b)
+}
@@ -0,0 +1,191 @@
+package signing_test
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"time"
+
+	typesv1 "github.com/agntcy/oasf/proto/go/agntcy/oasf/types/v1"
+	typesv1alpha1 "github.com/agntcy/oasf/proto/go/agntcy/oasf/types/v1alpha1"
+	"github.com/agntcy/oasf/proto/go/canonical"
+	"github.com/agntcy/oasf/proto/go/signing"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var signedAt = time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+func newRecord() *typesv1alpha1.Record {
+	return &typesv1alpha1.Record{
+		Name:          "agntcy/agent",
+		Version:       "v1.0.0",
+		SchemaVersion: "0.7.0",
+		Authors:       []string{"AGNTCY"},
+		Skills:        []*typesv1alpha1.Skill{{Name: "natural_language_processing/summarization", Id: 10201}},
+	}
+}
+
+func newSigner(key crypto.Signer, opts ...signing.Option) *signing.Signer {
+	signer, err := signing.NewSigner(key, append(opts, signing.WithClock(func() time.Time { return signedAt }))...)
+	Expect(err).NotTo(HaveOccurred())
+	return signer
+}
+
+func newTrustStore(keys ...crypto.PublicKey) *signing.TrustStore {
+	store, err := signing.NewTrustStore(keys...)
+	Expect(err).NotTo(HaveOccurred())
+	return store
+}
+
+var _ = Describe("Signing", func() {
+	var key *ecdsa.PrivateKey
+
+	BeforeEach(func() {
+		var err error
+		key, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	DescribeTable("should sign and verify records",
+		func(generate func() crypto.Signer, algorithm string) {
+			key := generate()
+			record := newRecord()
+			Expect(newSigner(key).SignRecord(record)).To(Succeed())
+
+			signature := record.GetSignature()
+			Expect(signature.GetAlgorithm()).To(Equal(algorithm))
+			Expect(signature.GetSignedAt()).To(Equal("2025-06-01T12:00:00Z"))
+			Expect(signature.GetContentType()).To(Equal(signing.ContentTypeDigest))
+			digest, err := canonical.Digest(newRecord())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(signature.GetContentBundle()).To(Equal(base64.StdEncoding.EncodeToString([]byte(digest))))
+
+			Expect(newTrustStore(key.Public()).VerifyRecord(record)).To(Succeed())
+		},
+		Entry("ECDSA P-256", func() crypto.Signer {
+			key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			return key
+		}, signing.AlgorithmECDSAP256SHA256),
+		Entry("Ed25519", func() crypto.Signer {
+			_, key, _ := ed25519.GenerateKey(rand.Reader)
+			return key
+		}, signing.AlgorithmEd25519),
+	)
+
+	It("should exclude the signature from the signed digest", func() {
+		record := newRecord()
+		Expect(newSigner(key).SignRecord(record)).To(Succeed())
+		signed, err := signing.Digest(record)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(signing.Digest(newRecord())).To(Equal(signed))
+		Expect(record.GetSignature()).NotTo(BeNil(), "the record must not be modified")
+	})
+
+	It("should sign records of any version", func() {
+		record := &typesv1.Record{Name: "agntcy/agent", Version: "v1.0.0", SchemaVersion: "1.0.0"}
+		signature, err := newSigner(key).Sign(record)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(newTrustStore(&key.PublicKey).Verify(record, signature)).To(Succeed())
+	})
+
+	It("should reject unsupported keys", func() {
+		p384, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = signing.NewSigner(p384)
+		Expect(err).To(MatchError("unsupported ECDSA curve P-384, expected P-256"))
+		_, err = signing.NewTrustStore(&p384.PublicKey)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should refuse unsigned, untrusted and tampered records", func() {
+		store := newTrustStore(&key.PublicKey)
+		Expect(store.VerifyRecord(newRecord())).To(MatchError(signing.ErrUnsigned))
+
+		record := newRecord()
+		other, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(newSigner(other).SignRecord(record)).To(Succeed())
+		Expect(store.VerifyRecord(record)).To(MatchError(signing.ErrUntrusted))
+
+		Expect(newSigner(key).SignRecord(record)).To(Succeed())
+		record.Version = "v1.0.1"
+		err = store.VerifyRecord(record)
+		Expect(err).To(MatchError(signing.ErrInvalidSignature))
+		Expect(err).To(MatchError(ContainSubstring("differs from the signed digest")))
+
+		// Without the digest bundle, the signature itself must not match.
+		record.Signature.ContentType = ""
+		record.Signature.ContentBundle = ""
+		Expect(store.VerifyRecord(record)).To(MatchError(ContainSubstring("signature does not match the record")))
+	})
+
+	It("should reject malformed signatures", func() {
+		record := newRecord()
+		Expect(newSigner(key).SignRecord(record)).To(Succeed())
+		store := newTrustStore(&key.PublicKey)
+
+		record.Signature.Algorithm = signing.AlgorithmEd25519
+		Expect(store.VerifyRecord(record)).To(MatchError(ContainSubstring(`algorithm "ED25519" does not match the ECDSA_P256_SHA256 signing key`)))
+
+		record.Signature.Algorithm = signing.AlgorithmECDSAP256SHA256
+		record.Signature.Signature = base64.StdEncoding.EncodeToString([]byte("forged"))
+		Expect(store.VerifyRecord(record)).To(MatchError(signing.ErrInvalidSignature))
+
+		record.Signature.Certificate = ""
+		Expect(store.VerifyRecord(record)).To(MatchError(ContainSubstring("certificate is required")))
+	})
+
+	It("should embed certificates and load trust stores from PEM files", func() {
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(1),
+			Subject:      pkix.Name{CommonName: "agntcy"},
+			NotBefore:    signedAt.Add(-time.Hour),
+			NotAfter:     signedAt.Add(time.Hour),
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+		Expect(err).NotTo(HaveOccurred())
+		certificate, err := x509.ParseCertificate(der)
+		Expect(err).NotTo(HaveOccurred())
+
+		dir := GinkgoT().TempDir()
+		Expect(os.WriteFile(filepath.Join(dir, "agntcy.pem"), pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600)).To(Succeed())
+		store, err := signing.LoadTrustStore(dir)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(store.Trusts(&key.PublicKey)).To(BeTrue())
+		store.SetClock(func() time.Time { return signedAt })
+
+		record := newRecord()
+		Expect(newSigner(key, signing.WithCertificate(certificate)).SignRecord(record)).To(Succeed())
+		Expect(record.GetSignature().GetCertificate()).To(Equal(base64.StdEncoding.EncodeToString(der)))
+		Expect(store.VerifyRecord(record)).To(Succeed())
+
+		// A zero trust store verifies at the current time.
+		zero := &signing.TrustStore{}
+		Expect(zero.Add(&key.PublicKey)).To(Succeed())
+		Expect(zero.VerifyRecord(record)).To(MatchError(signing.ErrUntrusted))
+
+		// The certificate must be valid when verifying, whatever signed_at says.
+		store.SetClock(func() time.Time { return signedAt.Add(2 * time.Hour) })
+		Expect(store.VerifyRecord(record)).To(MatchError(signing.ErrUntrusted))
+		Expect(record.GetSignature().GetSignedAt()).To(Equal(signedAt.Format(time.RFC3339)))
+
+		_, other, err := ed25519.GenerateKey(rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = signing.NewSigner(other, signing.WithCertificate(certificate))
+		Expect(err).To(MatchError("certificate does not match the signing key"))
+
+		Expect(os.WriteFile(filepath.Join(dir, "empty.pem"), nil, 0o600)).To(Succeed())
+		_, err = signing.LoadTrustStore(dir)
+		Expect(err).To(MatchError(ContainSubstring("empty.pem: no PUBLIC KEY or CERTIFICATE PEM block found")))
+	})
+})
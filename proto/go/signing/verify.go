@@ -0,0 +1,232 @@
+package signing
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	typesv1alpha1 "github.com/agntcy/oasf/proto/go/agntcy/oasf/types/v1alpha1"
+	"google.golang.org/protobuf/proto"
+)
+
+// Verification errors, wrapped by the errors returned from Verify.
+var (
+	// ErrUnsigned is returned for records without a signature.
+	ErrUnsigned = errors.New("record is not signed")
+	// ErrUntrusted is returned when the signing key is not in the trust store.
+	ErrUntrusted = errors.New("signing key is not trusted")
+	// ErrInvalidSignature is returned for malformed signatures and for
+	// signatures that do not match the record, e.g. a tampered record.
+	ErrInvalidSignature = errors.New("invalid signature")
+)
+
+// TrustStore holds the public keys whose signatures are trusted.
+type TrustStore struct {
+	keys []crypto.PublicKey
+	now  func() time.Time
+}
+
+// NewTrustStore returns a trust store with the given keys.
+func NewTrustStore(keys ...crypto.PublicKey) (*TrustStore, error) {
+	t := &TrustStore{}
+	for _, key := range keys {
+		if err := t.Add(key); err != nil {
+			return nil, err
+		}
+	}
+	return t, nil
+}
+
+// LoadTrustStore returns a trust store with the keys of the given PEM files.
+// Directories are read for their *.pem files.
+func LoadTrustStore(paths ...string) (*TrustStore, error) {
+	t := &TrustStore{}
+	for _, path := range paths {
+		files := []string{path}
+		if info, err := os.Stat(path); err != nil {
+			return nil, err
+		} else if info.IsDir() {
+			if files, err = filepath.Glob(filepath.Join(path, "*.pem")); err != nil {
+				return nil, err
+			}
+		}
+		for _, file := range files {
+			data, err := os.ReadFile(file)
+			if err != nil {
+				return nil, err
+			}
+			if err := t.AddPEM(data); err != nil {
+				return nil, fmt.Errorf("%s: %w", file, err)
+			}
+		}
+	}
+	return t, nil
+}
+
+// Add trusts an ECDSA P-256 or Ed25519 public key.
+func (t *TrustStore) Add(key crypto.PublicKey) error {
+	if _, err := algorithmOf(key); err != nil {
+		return err
+	}
+	if !t.Trusts(key) {
+		t.keys = append(t.keys, key)
+	}
+	return nil
+}
+
+// AddPEM trusts the keys of the PUBLIC KEY and CERTIFICATE blocks of PEM data.
+func (t *TrustStore) AddPEM(data []byte) error {
+	found := false
+	for {
+		var block *pem.Block
+		if block, data = pem.Decode(data); block == nil {
+			break
+		}
+		var key crypto.PublicKey
+		var err error
+		switch block.Type {
+		case "PUBLIC KEY":
+			key, err = x509.ParsePKIXPublicKey(block.Bytes)
+		case "CERTIFICATE":
+			var certificate *x509.Certificate
+			if certificate, err = x509.ParseCertificate(block.Bytes); err == nil {
+				key = certificate.PublicKey
+			}
+		default:
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if err := t.Add(key); err != nil {
+			return err
+		}
+		found = true
+	}
+	if !found {
+		return fmt.Errorf("no PUBLIC KEY or CERTIFICATE PEM block found")
+	}
+	return nil
+}
+
+// SetClock sets the function returning the verification time, at which
+// embedded certificates must be valid. It defaults to time.Now.
+func (t *TrustStore) SetClock(now func() time.Time) {
+	t.now = now
+}
+
+func (t *TrustStore) clock() time.Time {
+	if t.now == nil {
+		return time.Now()
+	}
+	return t.now()
+}
+
+// Trusts reports whether the key is in the trust store.
+func (t *TrustStore) Trusts(key crypto.PublicKey) bool {
+	return slices.ContainsFunc(t.keys, func(trusted crypto.PublicKey) bool {
+		return publicKeysEqual(trusted, key)
+	})
+}
+
+// Verify checks that the signature was made over the record by a trusted key.
+// An embedded certificate must be valid at the verification time: signed_at is
+// not covered by the signature, so it cannot prove when the record was signed.
+func (t *TrustStore) Verify(record proto.Message, signature *typesv1alpha1.Signature) error {
+	if signature == nil {
+		return ErrUnsigned
+	}
+	invalid := func(format string, args ...any) error {
+		return fmt.Errorf("%w: %s", ErrInvalidSignature, fmt.Sprintf(format, args...))
+	}
+
+	key, certificate, err := parseCertificate(signature.GetCertificate())
+	if err != nil {
+		return invalid("%s", err)
+	}
+	if !t.Trusts(key) {
+		return ErrUntrusted
+	}
+	algorithm, err := algorithmOf(key)
+	if err != nil {
+		return invalid("%s", err)
+	}
+	if signature.GetAlgorithm() != algorithm {
+		return invalid("algorithm %q does not match the %s signing key", signature.GetAlgorithm(), algorithm)
+	}
+	if _, err := time.Parse(time.RFC3339, signature.GetSignedAt()); err != nil {
+		return invalid("signed_at %q is not an RFC3339 timestamp", signature.GetSignedAt())
+	}
+	if now := t.clock().UTC(); certificate != nil && (now.Before(certificate.NotBefore) || now.After(certificate.NotAfter)) {
+		return fmt.Errorf("%w: certificate is not valid at %s", ErrUntrusted, now.Format(time.RFC3339))
+	}
+	value, err := base64.StdEncoding.DecodeString(signature.GetSignature())
+	if err != nil {
+		return invalid("signature is not base64 encoded")
+	}
+
+	digest, err := Digest(record)
+	if err != nil {
+		return err
+	}
+	if signature.GetContentType() == ContentTypeDigest {
+		signed, err := base64.StdEncoding.DecodeString(signature.GetContentBundle())
+		if err != nil {
+			return invalid("content bundle is not base64 encoded")
+		}
+		if string(signed) != digest {
+			return invalid("record digest %s differs from the signed digest %s", digest, signed)
+		}
+	}
+	sum, err := hex.DecodeString(strings.TrimPrefix(digest, "sha256:"))
+	if err != nil {
+		return err
+	}
+	var ok bool
+	switch key := key.(type) {
+	case *ecdsa.PublicKey:
+		ok = ecdsa.VerifyASN1(key, sum, value)
+	case ed25519.PublicKey:
+		ok = ed25519.Verify(key, sum, value)
+	}
+	if !ok {
+		return invalid("signature does not match the record")
+	}
+	return nil
+}
+
+// VerifyRecord checks the signature embedded in a record.
+func (t *TrustStore) VerifyRecord(record *typesv1alpha1.Record) error {
+	return t.Verify(record, record.GetSignature())
+}
+
+// parseCertificate returns the public key of a base64-encoded X.509
+// certificate or PKIX public key, and the certificate if there is one.
+func parseCertificate(encoded string) (crypto.PublicKey, *x509.Certificate, error) {
+	if encoded == "" {
+		return nil, nil, fmt.Errorf("certificate is required")
+	}
+	data, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, nil, fmt.Errorf("certificate is not base64 encoded")
+	}
+	if certificate, err := x509.ParseCertificate(data); err == nil {
+		return certificate.PublicKey, certificate, nil
+	}
+	key, err := x509.ParsePKIXPublicKey(data)
+	if err != nil {
+		return nil, nil, fmt.Errorf("certificate is neither an X.509 certificate nor a public key")
+	}
+	return key, nil, nil
+}
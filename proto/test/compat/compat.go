@@ -0,0 +1,368 @@
+// Package compat checks the wire and JSON compatibility of two proto trees,
+// such as two versions of the types package or a git revision and the working
+// tree. It covers the field-level rules of the WIRE_JSON breaking category of
+// buf without requiring buf.
+package compat
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/emicklei/proto"
+)
+
+// Kind classifies an incompatibility.
+type Kind string
+
+const (
+	// NumberReused marks a number given to another field, or a reserved number in use.
+	NumberReused Kind = "number_reused"
+	// TypeChanged marks a field whose type or cardinality changed.
+	TypeChanged Kind = "type_changed"
+	// Removed marks a field or enum value removed without reserving it.
+	Removed Kind = "removed"
+	// JSONNameChanged marks a field or enum value whose JSON name changed.
+	JSONNameChanged Kind = "json_name_changed"
+)
+
+// Finding is a single incompatibility between two trees.
+type Finding struct {
+	Kind Kind
+	// Element is the message or enum qualified name of the field or value,
+	// e.g. Record.name.
+	Element string
+	// Position is the file and line of the element in the tree it was found in.
+	Position string
+	Message  string
+}
+
+func (f Finding) String() string {
+	return fmt.Sprintf("%s: %s: %s", f.Position, f.Element, f.Message)
+}
+
+// Tree holds the messages and enums of a proto tree, keyed by their name
+// within the package, e.g. Record or Record.Nested. Packages are ignored so
+// that different versions of a package can be compared.
+type Tree struct {
+	Messages map[string]*Message
+	Enums    map[string]*Enum
+}
+
+// Message is a message of a tree.
+type Message struct {
+	Name     string
+	Fields   map[int]*Field
+	Reserved Reserved
+}
+
+// Field is a field of a message, including oneof and map fields.
+type Field struct {
+	Name   string
+	Number int
+	// Type is the value type, e.g. "string", "Locator" or "map<string, string>".
+	Type     string
+	Repeated bool
+	JSONName string
+	Position string
+}
+
+// Enum is an enum of a tree.
+type Enum struct {
+	Name     string
+	Values   map[int]*EnumValue
+	Reserved Reserved
+}
+
+// EnumValue is a value of an enum. Aliases sharing a number keep the first name.
+type EnumValue struct {
+	Name     string
+	Number   int
+	Position string
+}
+
+// Reserved holds the reserved numbers and names of a message or enum.
+type Reserved struct {
+	Ranges []proto.Range
+	Names  []string
+}
+
+// HasNumber reports whether the number is reserved.
+func (r Reserved) HasNumber(number int) bool {
+	for _, rng := range r.Ranges {
+		if number >= rng.From && (rng.Max || number <= rng.To) {
+			return true
+		}
+	}
+	return false
+}
+
+// HasName reports whether the name is reserved.
+func (r Reserved) HasName(name string) bool {
+	return slices.Contains(r.Names, name)
+}
+
+// NewTree returns an empty tree.
+func NewTree() *Tree {
+	return &Tree{Messages: make(map[string]*Message), Enums: make(map[string]*Enum)}
+}
+
+// ParseDir parses the proto files of a directory and its subdirectories.
+func ParseDir(dir string) (*Tree, error) {
+	tree := NewTree()
+	err := filepath.WalkDir(dir, func(path string, entry os.DirEntry, err error) error {
+		if err != nil || entry.IsDir() || filepath.Ext(path) != ".proto" {
+			return err
+		}
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		return tree.Parse(filepath.ToSlash(rel), file)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return tree, nil
+}
+
+// Parse adds the messages and enums of a proto file to the tree. The name is
+// used in the positions of the findings.
+func (t *Tree) Parse(name string, r io.Reader) error {
+	parser := proto.NewParser(r)
+	parser.Filename(name)
+	definition, err := parser.Parse()
+	if err != nil {
+		return fmt.Errorf("failed to parse proto file %s: %w", name, err)
+	}
+	for _, element := range definition.Elements {
+		switch element := element.(type) {
+		case *proto.Message:
+			t.addMessage("", element)
+		case *proto.Enum:
+			t.addEnum("", element)
+		}
+	}
+	return nil
+}
+
+func (t *Tree) addMessage(scope string, m *proto.Message) {
+	message := &Message{Name: scope + m.Name, Fields: make(map[int]*Field)}
+	t.Messages[message.Name] = message
+
+	var add func(elements []proto.Visitee)
+	add = func(elements []proto.Visitee) {
+		for _, element := range elements {
+			switch element := element.(type) {
+			case *proto.NormalField:
+				message.Fields[element.Sequence] = newField(element.Field, element.Type, element.Repeated)
+			case *proto.MapField:
+				message.Fields[element.Sequence] = newField(element.Field, fmt.Sprintf("map<%s, %s>", element.KeyType, element.Type), false)
+			case *proto.OneOfField:
+				message.Fields[element.Sequence] = newField(element.Field, element.Type, false)
+			case *proto.Oneof:
+				add(element.Elements)
+			case *proto.Reserved:
+				message.Reserved.Ranges = append(message.Reserved.Ranges, element.Ranges...)
+				message.Reserved.Names = append(message.Reserved.Names, element.FieldNames...)
+			case *proto.Message:
+				t.addMessage(message.Name+".", element)
+			case *proto.Enum:
+				t.addEnum(message.Name+".", element)
+			}
+		}
+	}
+	add(m.Elements)
+}
+
+func newField(f *proto.Field, fieldType string, repeated bool) *Field {
+	field := &Field{
+		Name:     f.Name,
+		Number:   f.Sequence,
+		Type:     fieldType,
+		Repeated: repeated,
+		JSONName: jsonName(f.Name),
+		Position: position(f.Position.Filename, f.Position.Line),
+	}
+	for _, option := range f.Options {
+		if option.Name == "json_name" {
+			field.JSONName = option.Constant.Source
+		}
+	}
+	return field
+}
+
+func (t *Tree) addEnum(scope string, e *proto.Enum) {
+	enum := &Enum{Name: scope + e.Name, Values: make(map[int]*EnumValue)}
+	t.Enums[enum.Name] = enum
+	for _, element := range e.Elements {
+		switch element := element.(type) {
+		case *proto.EnumField:
+			if enum.Values[element.Integer] == nil {
+				enum.Values[element.Integer] = &EnumValue{
+					Name:     element.Name,
+					Number:   element.Integer,
+					Position: position(element.Position.Filename, element.Position.Line),
+				}
+			}
+		case *proto.Reserved:
+			enum.Reserved.Ranges = append(enum.Reserved.Ranges, element.Ranges...)
+			enum.Reserved.Names = append(enum.Reserved.Names, element.FieldNames...)
+		}
+	}
+}
+
+func position(file string, line int) string {
+	return fmt.Sprintf("%s:%d", file, line)
+}
+
+// jsonName returns the default JSON name of a field, as computed by protoc.
+func jsonName(name string) string {
+	var b strings.Builder
+	upper := false
+	for _, r := range name {
+		switch {
+		case r == '_':
+			upper = true
+		case upper:
+			b.WriteRune(unicode.ToUpper(r))
+			upper = false
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// Compare reports the changes of next that break readers of prev, on the wire
+// or in JSON. Only the messages and enums present in both trees are compared;
+// removing a whole message or enum does not affect the encoding of the others.
+func Compare(prev, next *Tree) []Finding {
+	var findings []Finding
+	for _, name := range sortedKeys(prev.Messages) {
+		if message := next.Messages[name]; message != nil {
+			findings = append(findings, compareMessages(prev.Messages[name], message)...)
+		}
+	}
+	for _, name := range sortedKeys(prev.Enums) {
+		if enum := next.Enums[name]; enum != nil {
+			findings = append(findings, compareEnums(prev.Enums[name], enum)...)
+		}
+	}
+	return findings
+}
+
+func compareMessages(prev, next *Message) []Finding {
+	var findings []Finding
+	add := func(kind Kind, field *Field, format string, args ...any) {
+		findings = append(findings, Finding{
+			Kind:     kind,
+			Element:  prev.Name + "." + field.Name,
+			Position: field.Position,
+			Message:  fmt.Sprintf(format, args...),
+		})
+	}
+
+	for _, number := range sortedKeys(prev.Fields) {
+		old := prev.Fields[number]
+		field := next.Fields[number]
+		switch {
+		case field == nil:
+			var missing []string
+			if !next.Reserved.HasNumber(number) {
+				missing = append(missing, fmt.Sprintf("number %d", number))
+			}
+			if !next.Reserved.HasName(old.Name) {
+				missing = append(missing, fmt.Sprintf("name %q", old.Name))
+			}
+			if len(missing) > 0 {
+				add(Removed, old, "field %d was removed without reserving its %s", number, strings.Join(missing, " and "))
+			}
+			continue
+		case field.Name != old.Name && !sameType(old, field):
+			add(NumberReused, old, "field number %d was reused by %s %s", number, field.signature(), field.Name)
+			continue
+		case !sameType(old, field):
+			add(TypeChanged, old, "field %d changed from %s to %s", number, old.signature(), field.signature())
+		}
+		if field.JSONName != old.JSONName {
+			add(JSONNameChanged, old, "field %d changed its JSON name from %q to %q (%s)", number, old.JSONName, field.JSONName, field.Position)
+		}
+	}
+
+	for _, number := range sortedKeys(next.Fields) {
+		if field := next.Fields[number]; prev.Fields[number] == nil && prev.Reserved.HasNumber(number) {
+			findings = append(findings, Finding{
+				Kind:     NumberReused,
+				Element:  next.Name + "." + field.Name,
+				Position: field.Position,
+				Message:  fmt.Sprintf("field %d uses a number reserved in the previous version", number),
+			})
+		}
+	}
+	return findings
+}
+
+func compareEnums(prev, next *Enum) []Finding {
+	var findings []Finding
+	for _, number := range sortedKeys(prev.Values) {
+		old := prev.Values[number]
+		value := next.Values[number]
+		element := prev.Name + "." + old.Name
+		switch {
+		case value == nil && (!next.Reserved.HasNumber(number) || !next.Reserved.HasName(old.Name)):
+			findings = append(findings, Finding{
+				Kind:     Removed,
+				Element:  element,
+				Position: old.Position,
+				Message:  fmt.Sprintf("value %d was removed without reserving its number and name", number),
+			})
+		case value != nil && value.Name != old.Name:
+			findings = append(findings, Finding{
+				Kind:     JSONNameChanged,
+				Element:  element,
+				Position: old.Position,
+				Message:  fmt.Sprintf("value %d was renamed to %s (%s)", number, value.Name, value.Position),
+			})
+		}
+	}
+	return findings
+}
+
+// sameType reports whether two fields share their type and cardinality.
+// Message and enum types are compared by name within their package.
+func sameType(a, b *Field) bool {
+	return a.Repeated == b.Repeated && unqualified(a.Type) == unqualified(b.Type)
+}
+
+func unqualified(typeName string) string {
+	if strings.HasPrefix(typeName, "google.protobuf.") || strings.HasPrefix(typeName, "map<") {
+		return typeName
+	}
+	return typeName[strings.LastIndex(typeName, ".")+1:]
+}
+
+func (f *Field) signature() string {
+	if f.Repeated {
+		return "repeated " + f.Type
+	}
+	return f.Type
+}
+
+func sortedKeys[K int | string, V any](m map[K]V) []K {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
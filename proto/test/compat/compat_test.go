@@ -0,0 +1,147 @@
+package compat_test
+
+import (
+	"os"
+	"os/exec"
+	"strings"
+
+	"proto/compat"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const typesRoot = "../../agntcy/oasf/types/"
+
+func parse(source string) *compat.Tree {
+	tree := compat.NewTree()
+	Expect(tree.Parse("test.proto", strings.NewReader("syntax = \"proto3\";\npackage test;\n"+source))).To(Succeed())
+	return tree
+}
+
+func findings(prev, next string) []string {
+	var result []string
+	for _, finding := range compat.Compare(parse(prev), parse(next)) {
+		result = append(result, string(finding.Kind)+" "+finding.String())
+	}
+	return result
+}
+
+var _ = Describe("Wire compatibility", func() {
+	It("should accept compatible changes", func() {
+		Expect(findings(
+			`message A { string name = 1; int32 size = 2; }`,
+			`message A { string name = 1; reserved 2; reserved "size"; repeated string urls = 3; }`,
+		)).To(BeEmpty())
+		Expect(findings(
+			`message A { string name = 1; }`,
+			`message A { oneof value { string name = 1; } }`,
+		)).To(BeEmpty())
+	})
+
+	It("should flag reused field numbers", func() {
+		Expect(findings(
+			`message A { string name = 1; }`,
+			`message A { map<string, string> annotations = 1; }`,
+		)).To(ConsistOf(
+			"number_reused test.proto:3: A.name: field number 1 was reused by map<string, string> annotations",
+		))
+		Expect(findings(
+			`message A { reserved 2 to 4; }`,
+			`message A { string digest = 3; }`,
+		)).To(ConsistOf(
+			"number_reused test.proto:3: A.digest: field 3 uses a number reserved in the previous version",
+		))
+	})
+
+	It("should flag type and cardinality changes", func() {
+		Expect(findings(
+			`message A { uint64 id = 1; string url = 2; B b = 3; } message B {}`,
+			`message A { uint32 id = 1; repeated string url = 2; test.B b = 3; } message B {}`,
+		)).To(ConsistOf(
+			"type_changed test.proto:3: A.id: field 1 changed from uint64 to uint32",
+			"type_changed test.proto:3: A.url: field 2 changed from string to repeated string",
+		))
+	})
+
+	It("should flag fields removed without reservation", func() {
+		Expect(findings(
+			`message A { string name = 1; string url = 2; string digest = 3; }`,
+			`message A { reserved 2; reserved "digest"; }`,
+		)).To(ConsistOf(
+			`removed test.proto:3: A.name: field 1 was removed without reserving its number 1 and name "name"`,
+			`removed test.proto:3: A.url: field 2 was removed without reserving its name "url"`,
+			`removed test.proto:3: A.digest: field 3 was removed without reserving its number 3`,
+		))
+	})
+
+	It("should flag JSON name changes", func() {
+		Expect(findings(
+			`message A { string schema_version = 1; string name = 2; }`,
+			"message A {\n string version = 1;\n string name = 2 [json_name = \"title\"];\n}",
+		)).To(ConsistOf(
+			`json_name_changed test.proto:3: A.schema_version: field 1 changed its JSON name from "schemaVersion" to "version" (test.proto:4)`,
+			`json_name_changed test.proto:3: A.name: field 2 changed its JSON name from "name" to "title" (test.proto:5)`,
+		))
+		Expect(findings(
+			`enum T { T_UNSPECIFIED = 0; T_DOCKER_IMAGE = 1; T_BINARY = 2; }`,
+			"enum T {\n T_UNSPECIFIED = 0;\n T_CONTAINER_IMAGE = 1;\n}",
+		)).To(ConsistOf(
+			"json_name_changed test.proto:3: T.T_DOCKER_IMAGE: value 1 was renamed to T_CONTAINER_IMAGE (test.proto:5)",
+			"removed test.proto:3: T.T_BINARY: value 2 was removed without reserving its number and name",
+		))
+	})
+
+	It("should compare nested messages", func() {
+		Expect(findings(
+			`message A { message B { string name = 1; } }`,
+			`message A { message B { bytes name = 1; } }`,
+		)).To(ConsistOf("type_changed test.proto:3: A.B.name: field 1 changed from string to bytes"))
+	})
+
+	It("should report the incompatibilities between proto versions", func() {
+		prev, err := compat.ParseDir(typesRoot + "v1alpha2")
+		Expect(err).NotTo(HaveOccurred())
+		next, err := compat.ParseDir(typesRoot + "v1")
+		Expect(err).NotTo(HaveOccurred())
+
+		var elements []string
+		for _, finding := range compat.Compare(prev, next) {
+			elements = append(elements, string(finding.Kind)+" "+finding.Element)
+		}
+		Expect(elements).To(ContainElements(
+			"number_reused Record.name",
+			"json_name_changed Locator.url",
+			"removed Locator.size",
+			"json_name_changed LocatorType.LOCATOR_TYPE_DOCKER_IMAGE",
+		))
+
+		Expect(compat.Compare(next, next)).To(BeEmpty())
+	})
+
+	// PROTO_BREAKING_AGAINST selects the git revision types/v1 must stay
+	// compatible with, e.g. origin/main. It defaults to HEAD.
+	It("should keep types/v1 compatible with the base revision", func() {
+		if _, err := exec.LookPath("git"); err != nil {
+			Skip("git is not available")
+		}
+		rev := os.Getenv("PROTO_BREAKING_AGAINST")
+		if rev == "" {
+			rev = "HEAD"
+		}
+		prev, err := compat.ParseGit(rev, typesRoot+"v1")
+		if err != nil && os.Getenv("PROTO_BREAKING_AGAINST") == "" {
+			Skip("no git history: " + err.Error())
+		}
+		Expect(err).NotTo(HaveOccurred())
+		next, err := compat.ParseDir(typesRoot + "v1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(prev.Messages).NotTo(BeEmpty())
+
+		var errors []string
+		for _, finding := range compat.Compare(prev, next) {
+			errors = append(errors, finding.String())
+		}
+		Expect(errors).To(BeEmpty(), "Breaking changes against %s:\n%s", rev, strings.Join(errors, "\n"))
+	})
+})
@@ -0,0 +1,51 @@
+package compat
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+	"path"
+	"strings"
+)
+
+// ParseGit parses the proto files of a directory of the working tree as they
+// were at the given git revision. File names in the findings are relative to
+// the directory, like with ParseDir.
+func ParseGit(rev, dir string) (*Tree, error) {
+	prefix, err := git(dir, "rev-parse", "--show-prefix")
+	if err != nil {
+		return nil, err
+	}
+	prefix = strings.TrimSpace(prefix)
+
+	list, err := git(dir, "ls-tree", "-r", "--name-only", "--full-name", rev, "--", ".")
+	if err != nil {
+		return nil, err
+	}
+	tree := NewTree()
+	for _, file := range strings.Fields(list) {
+		if path.Ext(file) != ".proto" {
+			continue
+		}
+		content, err := git(dir, "show", rev+":"+file)
+		if err != nil {
+			return nil, err
+		}
+		if err := tree.Parse(strings.TrimPrefix(file, prefix), strings.NewReader(content)); err != nil {
+			return nil, fmt.Errorf("%s: %w", rev, err)
+		}
+	}
+	return tree, nil
+}
+
+func git(dir string, args ...string) (string, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("git %s: %w: %s", strings.Join(args, " "), err, strings.TrimSpace(stderr.String()))
+	}
+	return stdout.String(), nil
+}
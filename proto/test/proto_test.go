@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
+	"unicode"
 
 	"github.com/emicklei/proto"
 	. "github.com/onsi/ginkgo/v2"
@@ -15,6 +17,7 @@ import (
 )
 
 type JsonSchema struct {
+	Path        string                   `json:"-"`
 	Caption     string                   `json:"caption"`
 	Description string                   `json:"description"`
 	Extends     string                   `json:"extends"`
@@ -27,6 +30,10 @@ type JsonAttribute struct {
 	Description string `json:"description"`
 	Requirement string `json:"requirement"`
 	Reference   string `json:"reference,omitempty"`
+	// Enum holds the allowed values of the attribute, keyed by value.
+	Enum map[string]struct {
+		Caption string `json:"caption"`
+	} `json:"enum,omitempty"`
 }
 
 type ProtoField struct {
@@ -34,12 +41,63 @@ type ProtoField struct {
 	Type        string
 	Comment     string
 	FieldNumber int
+	Repeated    bool
+	// KeyType is set for map fields, whose Type holds the value type.
+	KeyType string
+}
+
+// Signature returns the declared type of the field, e.g. "repeated Locator" or
+// "map<string, string>". Message types are stripped of their package, except
+// for the well-known google.protobuf types.
+func (f ProtoField) Signature() string {
+	fieldType := f.Type
+	if !strings.HasPrefix(fieldType, "google.protobuf.") {
+		fieldType = fieldType[strings.LastIndex(fieldType, ".")+1:]
+	}
+	switch {
+	case f.KeyType != "":
+		return fmt.Sprintf("map<%s, %s>", f.KeyType, fieldType)
+	case f.Repeated:
+		return "repeated " + fieldType
+	}
+	return fieldType
 }
 
 type ProtoMessage struct {
+	Path    string
 	Name    string
 	Fields  map[string]ProtoField
 	Imports []string
+	Enums   map[string]ProtoEnum
+}
+
+type ProtoEnum struct {
+	Name   string
+	Values []ProtoEnumValue
+}
+
+type ProtoEnumValue struct {
+	Name   string
+	Number int
+}
+
+// StringForms returns the lowercase string form of every value, as used across
+// APIs. The prefix derived from the enum name, e.g. LOCATOR_TYPE_, is removed.
+func (e ProtoEnum) StringForms() []string {
+	var prefix strings.Builder
+	for i, r := range e.Name {
+		if i > 0 && unicode.IsUpper(r) {
+			prefix.WriteByte('_')
+		}
+		prefix.WriteRune(unicode.ToUpper(r))
+	}
+	prefix.WriteByte('_')
+
+	var forms []string
+	for _, value := range e.Values {
+		forms = append(forms, strings.ToLower(strings.TrimPrefix(value.Name, prefix.String())))
+	}
+	return forms
 }
 
 func parseJsonSchema(filePath string) (JsonSchema, error) {
@@ -51,9 +109,140 @@ func parseJsonSchema(filePath string) (JsonSchema, error) {
 	if err := json.Unmarshal(data, &schema); err != nil {
 		return JsonSchema{}, fmt.Errorf("failed to unmarshal JSON file %s: %w", filePath, err)
 	}
+	schema.Path = filePath
 	return schema, nil
 }
 
+type Dictionary struct {
+	// Root is the schema directory the referenced objects and classes are read from.
+	Root       string                         `json:"-"`
+	Attributes map[string]DictionaryAttribute `json:"attributes"`
+	Types      struct {
+		Attributes map[string]DictionaryType `json:"attributes"`
+	} `json:"types"`
+}
+
+type DictionaryAttribute struct {
+	Type       string `json:"type"`
+	IsArray    bool   `json:"is_array"`
+	ValueType  string `json:"value_type"`
+	ObjectType string `json:"object_type"`
+	ClassType  string `json:"class_type"`
+	Family     string `json:"family"`
+}
+
+type DictionaryType struct {
+	Type string `json:"type"`
+}
+
+func parseDictionary(schemaRoot string) (Dictionary, error) {
+	filePath := filepath.Join(schemaRoot, "dictionary.json")
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return Dictionary{}, fmt.Errorf("failed to read JSON file %s: %w", filePath, err)
+	}
+	var dictionary Dictionary
+	if err := json.Unmarshal(data, &dictionary); err != nil {
+		return Dictionary{}, fmt.Errorf("failed to unmarshal JSON file %s: %w", filePath, err)
+	}
+	dictionary.Root = schemaRoot
+	return dictionary, nil
+}
+
+// scalarProtoTypes lists the proto types accepted for each primitive dictionary type.
+var scalarProtoTypes = map[string][]string{
+	"string_t":     {"string"},
+	"integer_t":    {"int32", "uint32", "sint32", "fixed32", "sfixed32"},
+	"long_t":       {"int64", "uint64", "sint64", "fixed64", "sfixed64"},
+	"float_t":      {"float", "double"},
+	"boolean_t":    {"bool"},
+	"bytestring_t": {"bytes"},
+	"json_t":       {"google.protobuf.Struct", "google.protobuf.Value"},
+}
+
+// wellKnownProtoTypes lists proto types accepted for derived dictionary types
+// in addition to the ones of their primitive type.
+var wellKnownProtoTypes = map[string][]string{
+	"datetime_t":  {"google.protobuf.Timestamp"},
+	"timestamp_t": {"google.protobuf.Timestamp"},
+}
+
+// ProtoTypes returns the field signatures that may back the named dictionary
+// attribute, in the form returned by ProtoField.Signature.
+func (d Dictionary) ProtoTypes(name string) ([]string, error) {
+	attribute, ok := d.Attributes[name]
+	if !ok {
+		return nil, fmt.Errorf("attribute '%s' is not defined in the dictionary", name)
+	}
+	var types []string
+	switch {
+	case attribute.Type == "typed_map_t":
+		values, err := d.typeProtoTypes(attribute.ValueType)
+		if err != nil {
+			return nil, err
+		}
+		for _, value := range values {
+			types = append(types, fmt.Sprintf("map<string, %s>", value))
+		}
+		// Maps cannot be repeated.
+		return types, nil
+	case attribute.Type == "class_t":
+		message, err := d.messageName(attribute.Family+"s", attribute.ClassType)
+		if err != nil {
+			return nil, err
+		}
+		types = []string{message}
+	case attribute.Type == "object_t":
+		message, err := d.messageName("objects", attribute.ObjectType)
+		if err != nil {
+			return nil, err
+		}
+		types = []string{message, "google.protobuf.Struct"}
+	default:
+		var err error
+		if types, err = d.typeProtoTypes(attribute.Type); err != nil {
+			return nil, err
+		}
+	}
+	if attribute.IsArray {
+		for i := range types {
+			types[i] = "repeated " + types[i]
+		}
+	}
+	return types, nil
+}
+
+// typeProtoTypes returns the proto types of a dictionary type, following its
+// super types to the primitive one. Any other name refers to an object.
+func (d Dictionary) typeProtoTypes(typeName string) ([]string, error) {
+	if _, ok := d.Types.Attributes[typeName]; !ok {
+		message, err := d.messageName("objects", typeName)
+		if err != nil {
+			return nil, err
+		}
+		return []string{message, "google.protobuf.Struct"}, nil
+	}
+	var types []string
+	for seen := map[string]bool{}; typeName != "" && !seen[typeName]; typeName = d.Types.Attributes[typeName].Type {
+		seen[typeName] = true
+		types = append(types, wellKnownProtoTypes[typeName]...)
+		if scalars, ok := scalarProtoTypes[typeName]; ok {
+			return append(slices.Clone(scalars), types...), nil
+		}
+	}
+	return nil, fmt.Errorf("type '%s' has no proto equivalent", typeName)
+}
+
+// messageName returns the proto message name expected for a schema entity,
+// derived from its caption like the message name of the synced files.
+func (d Dictionary) messageName(dir, name string) (string, error) {
+	entity, err := parseJsonSchema(filepath.Join(d.Root, dir, name+".json"))
+	if err != nil {
+		return "", err
+	}
+	return strings.ReplaceAll(cases.Title(language.English).String(entity.Caption), " ", ""), nil
+}
+
 type protoVisitor struct {
 	proto.NoopVisitor
 	ProtoMessage *ProtoMessage
@@ -78,7 +267,18 @@ func (v *protoVisitor) VisitNormalField(f *proto.NormalField) {
 		Type:        f.Type,
 		Comment:     comment,
 		FieldNumber: f.Sequence,
+		Repeated:    f.Repeated,
+	}
+}
+
+func (v *protoVisitor) VisitEnum(e *proto.Enum) {
+	enum := ProtoEnum{Name: e.Name}
+	for _, each := range e.Elements {
+		if field, ok := each.(*proto.EnumField); ok {
+			enum.Values = append(enum.Values, ProtoEnumValue{Name: field.Name, Number: field.Integer})
+		}
 	}
+	v.ProtoMessage.Enums[e.Name] = enum
 }
 
 func (v *protoVisitor) VisitImport(i *proto.Import) {
@@ -108,6 +308,7 @@ func (v *protoVisitor) VisitMapField(f *proto.MapField) {
 		Type:        f.Type,
 		Comment:     comment,
 		FieldNumber: f.Sequence,
+		KeyType:     f.KeyType,
 	}
 }
 
@@ -125,7 +326,9 @@ func parseProtoFile(filePath string) (ProtoMessage, error) {
 	}
 
 	protoMsg := ProtoMessage{
+		Path:   filePath,
 		Fields: make(map[string]ProtoField),
+		Enums:  make(map[string]ProtoEnum),
 	}
 
 	visitor := &protoVisitor{ProtoMessage: &protoMsg}
@@ -138,7 +341,7 @@ func parseProtoFile(filePath string) (ProtoMessage, error) {
 	return protoMsg, nil
 }
 
-func compareSchemas(jsonSchema JsonSchema, protoMessage ProtoMessage) ([]string, []string) {
+func compareSchemas(jsonSchema JsonSchema, protoMessage ProtoMessage, dictionary Dictionary) ([]string, []string) {
 	var errors []string
 	var warnings []string
 
@@ -151,12 +354,26 @@ func compareSchemas(jsonSchema JsonSchema, protoMessage ProtoMessage) ([]string,
 	jsonAttributes := jsonSchema.Attributes
 	protoFields := protoMessage.Fields
 
-	for attrName := range jsonAttributes {
-		_, exists := protoFields[attrName]
+	for attrName, attr := range jsonAttributes {
+		protoField, exists := protoFields[attrName]
 		if !exists {
 			errors = append(errors, fmt.Sprintf("JSON attribute '%s' is missing in Proto message '%s'.", attrName, protoMessage.Name))
 			continue
 		}
+
+		dictName := attrName
+		if attr.Reference != "" {
+			dictName = attr.Reference
+		}
+		expected, err := dictionary.ProtoTypes(dictName)
+		if err != nil {
+			errors = append(errors, fmt.Sprintf("Cannot check the type of JSON attribute '%s' in %s: %s.", attrName, jsonSchema.Path, err))
+			continue
+		}
+		if actual := protoField.Signature(); !slices.Contains(expected, actual) {
+			errors = append(errors, fmt.Sprintf("Type mismatch for '%s': JSON %s expects one of [%s], but Proto %s declares '%s'.",
+				attrName, jsonSchema.Path, strings.Join(expected, ", "), protoMessage.Path, actual))
+		}
 	}
 
 	for fieldName := range protoFields {
@@ -168,51 +385,331 @@ func compareSchemas(jsonSchema JsonSchema, protoMessage ProtoMessage) ([]string,
 	return errors, warnings
 }
 
+// protoVersion describes a proto package checked against the JSON schema. The
+// historical packages shipped with older schemas, so the differences with the
+// current schema are allowlisted per version.
+type protoVersion struct {
+	dir string
+	// renamed maps the messages renamed since the version to their current
+	// name, which is the caption of their JSON counterpart.
+	renamed map[string]string
+	// unpaired lists the messages that no longer have a JSON counterpart.
+	unpaired []string
+	// omitted lists the JSON attributes the version intentionally lacks, keyed by
+	// proto message.
+	omitted map[string][]string
+	// omittedEnumValues and extraEnumValues list, per proto enum, the schema enum
+	// values the version lacks and the values it declares that were removed since.
+	omittedEnumValues map[string][]string
+	extraEnumValues   map[string][]string
+}
+
+var protoVersions = []protoVersion{
+	{dir: "v1"},
+	{
+		dir: "v1alpha2",
+		omitted: map[string][]string{
+			"Locator": {"urls"},
+			"Skill":   {"annotations"},
+			"Domain":  {"annotations"},
+			"Module":  {"artifact"},
+		},
+		omittedEnumValues: map[string][]string{
+			"LocatorType": {"container_image", "package", "url"},
+		},
+		extraEnumValues: map[string][]string{
+			"LocatorType": {"docker_image", "python_package"},
+		},
+	},
+	{
+		dir:      "v1alpha1",
+		unpaired: []string{"Signature"},
+		omitted: map[string][]string{
+			"Locator": {"urls"},
+			"Module":  {"artifact"},
+		},
+		omittedEnumValues: map[string][]string{
+			"LocatorType": {"container_image", "package", "url"},
+		},
+		extraEnumValues: map[string][]string{
+			"LocatorType": {"docker_image", "python_package"},
+		},
+	},
+	{
+		dir:      "v1alpha0",
+		renamed:  map[string]string{"Extension": "Module"},
+		unpaired: []string{"Signature"},
+		omitted: map[string][]string{
+			"Record":    {"domains", "modules"},
+			"Locator":   {"urls"},
+			"Skill":     {"name", "id"},
+			"Extension": {"id", "artifact"},
+		},
+		omittedEnumValues: map[string][]string{
+			"LocatorType": {"container_image", "package", "url"},
+		},
+		extraEnumValues: map[string][]string{
+			"LocatorType": {"docker_image", "python_package"},
+		},
+	},
+}
+
+// jsonSchemaDirs lists the schema directories whose objects and classes may be
+// the counterpart of a proto message.
+var jsonSchemaDirs = []string{"objects", "skills", "domains", "modules"}
+
+// indexJsonSchemas maps the message name derived from the caption of every
+// object and class to the files declaring it.
+func indexJsonSchemas(schemaRoot string) (map[string][]string, error) {
+	index := make(map[string][]string)
+	caser := cases.Title(language.English)
+	for _, dir := range jsonSchemaDirs {
+		err := filepath.WalkDir(filepath.Join(schemaRoot, dir), func(path string, entry os.DirEntry, err error) error {
+			if err != nil || entry.IsDir() || filepath.Ext(path) != ".json" {
+				return err
+			}
+			schema, err := parseJsonSchema(path)
+			if err != nil {
+				return err
+			}
+			name := strings.ReplaceAll(caser.String(schema.Caption), " ", "")
+			index[name] = append(index[name], path)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return index, nil
+}
+
+// parseProtoPackage parses every top-level message declared by the proto files
+// of a directory, in file order.
+func parseProtoPackage(dir string) ([]ProtoMessage, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.proto"))
+	if err != nil {
+		return nil, err
+	}
+	var messages []ProtoMessage
+	for _, file := range files {
+		reader, err := os.Open(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open proto file %s: %w", file, err)
+		}
+		definition, err := proto.NewParser(reader).Parse()
+		reader.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse proto file %s: %w", file, err)
+		}
+		for _, element := range definition.Elements {
+			message, ok := element.(*proto.Message)
+			if !ok {
+				continue
+			}
+			protoMsg := ProtoMessage{
+				Path:   file,
+				Name:   message.Name,
+				Fields: make(map[string]ProtoField),
+				Enums:  make(map[string]ProtoEnum),
+			}
+			visitor := &protoVisitor{ProtoMessage: &protoMsg}
+			for _, each := range message.Elements {
+				each.Accept(visitor)
+			}
+			messages = append(messages, protoMsg)
+		}
+	}
+	return messages, nil
+}
+
+// protoScalarTypes lists the proto scalar value types.
+var protoScalarTypes = []string{
+	"double", "float", "int32", "int64", "uint32", "uint64", "sint32", "sint64",
+	"fixed32", "fixed64", "sfixed32", "sfixed64", "bool", "string", "bytes",
+}
+
 var _ = Describe("JsonSchema and Proto synchronization", func() {
 	schemaRoot := "../../schema/"
-	protoRoot := "../agntcy/oasf/types/v1/"
 
-	type testCase struct {
-		entityType string
-		fileName   string
-		protoPath  string
-	}
-
-	cases := []testCase{
-		{"objects", "record.json", filepath.Join(protoRoot, "record.proto")},
-		{"objects", "locator.json", filepath.Join(protoRoot, "locator.proto")},
-		{"skills", "base_skill.json", filepath.Join(protoRoot, "skill.proto")},
-		{"domains", "base_domain.json", filepath.Join(protoRoot, "domain.proto")},
-		{"modules", "base_module.json", filepath.Join(protoRoot, "module.proto")},
-	}
-
-	for _, tc := range cases {
-		jsonPath := filepath.Join(schemaRoot, tc.entityType, tc.fileName)
-		It(fmt.Sprintf("should sync JSON schema %s and Proto %s", filepath.Base(jsonPath), filepath.Base(tc.protoPath)), func() {
-			jsonSchemaData, err := parseJsonSchema(jsonPath)
-			Expect(err).NotTo(HaveOccurred(), "Error parsing JSON: %v", err)
-
-			// Handle extends
-			if jsonSchemaData.Extends != "" {
-				extendsPath := filepath.Join(schemaRoot, tc.entityType, jsonSchemaData.Extends+".json")
-				extendedSchema, err := parseJsonSchema(extendsPath)
-				Expect(err).NotTo(HaveOccurred(), "Error parsing extended JSON: %v", err)
-				// Merge attributes: extended first, then main (main overrides)
-				for k, v := range extendedSchema.Attributes {
-					if _, exists := jsonSchemaData.Attributes[k]; !exists {
-						jsonSchemaData.Attributes[k] = v
+	var dictionary Dictionary
+	BeforeEach(func() {
+		var err error
+		dictionary, err = parseDictionary(schemaRoot)
+		Expect(err).NotTo(HaveOccurred(), "Error parsing dictionary: %v", err)
+	})
+
+	// Messages and JSON files are discovered while building the spec tree, so
+	// their errors are reported by dedicated specs.
+	jsonIndex, indexErr := indexJsonSchemas(schemaRoot)
+	It("should index the JSON schema objects and classes by caption", func() {
+		Expect(indexErr).NotTo(HaveOccurred(), "Error indexing JSON: %v", indexErr)
+	})
+
+	for _, version := range protoVersions {
+		protoRoot := filepath.Join("../agntcy/oasf/types", version.dir)
+		messages, parseErr := parseProtoPackage(protoRoot)
+		It(fmt.Sprintf("should parse the messages of Proto %s", version.dir), func() {
+			Expect(parseErr).NotTo(HaveOccurred(), "Error parsing Proto: %v", parseErr)
+			Expect(messages).NotTo(BeEmpty(), "No message found in %s", protoRoot)
+		})
+
+		declared := make(map[string]bool)
+		for _, message := range messages {
+			declared[message.Name] = true
+			for name := range message.Enums {
+				declared[name] = true
+			}
+		}
+
+		for _, protoMessageData := range messages {
+			It(fmt.Sprintf("should sync Proto %s/%s with its JSON schema", version.dir, protoMessageData.Name), func() {
+				var errors []string
+
+				// Every message a field refers to must be declared in the package,
+				// so that it is synced as well.
+				for _, fieldName := range sortedFieldNames(protoMessageData) {
+					field := protoMessageData.Fields[fieldName]
+					fieldType := field.Type[strings.LastIndex(field.Type, ".")+1:]
+					if strings.HasPrefix(field.Type, "google.protobuf.") || slices.Contains(protoScalarTypes, field.Type) || declared[fieldType] {
+						continue
 					}
+					errors = append(errors, fmt.Sprintf("Proto field '%s.%s' in %s refers to '%s', which is not declared in %s.",
+						protoMessageData.Name, fieldName, protoMessageData.Path, field.Type, protoRoot))
 				}
-			}
 
-			protoMessageData, err := parseProtoFile(tc.protoPath)
-			Expect(err).NotTo(HaveOccurred(), "Error parsing Proto: %v", err)
+				jsonName := protoMessageData.Name
+				if renamed, ok := version.renamed[jsonName]; ok {
+					jsonName = renamed
+				}
+				jsonPaths := jsonIndex[jsonName]
+				unpaired := slices.Contains(version.unpaired, protoMessageData.Name)
+				switch {
+				case len(jsonPaths) == 0 && unpaired:
+					Expect(errors).To(BeEmpty(), "Errors: %v", errors)
+					return
+				case len(jsonPaths) == 0:
+					errors = append(errors, fmt.Sprintf("Proto message '%s' in %s has no JSON object or class with caption '%s'.", protoMessageData.Name, protoMessageData.Path, jsonName))
+				case len(jsonPaths) > 1:
+					errors = append(errors, fmt.Sprintf("Proto message '%s' in %s matches several JSON files by caption: %v", protoMessageData.Name, protoMessageData.Path, jsonPaths))
+				case unpaired:
+					errors = append(errors, fmt.Sprintf("Allowlisted unpaired Proto message '%s' has a JSON counterpart %s. Remove it from the allowlist.", protoMessageData.Name, jsonPaths[0]))
+				}
+				if len(jsonPaths) != 1 {
+					Expect(errors).To(BeEmpty(), "Errors: %v", errors)
+					return
+				}
 
-			errors, warnings := compareSchemas(jsonSchemaData, protoMessageData)
-			for _, warn := range warnings {
-				GinkgoWriter.Printf("WARNING: %s\n", warn)
-			}
-			Expect(errors).To(BeEmpty(), "Errors: %v", errors)
-		})
+				jsonPath := jsonPaths[0]
+				jsonSchemaData, err := parseJsonSchema(jsonPath)
+				Expect(err).NotTo(HaveOccurred(), "Error parsing JSON: %v", err)
+
+				// Handle extends
+				if jsonSchemaData.Extends != "" {
+					extendsPath := filepath.Join(filepath.Dir(jsonPath), jsonSchemaData.Extends+".json")
+					extendedSchema, err := parseJsonSchema(extendsPath)
+					Expect(err).NotTo(HaveOccurred(), "Error parsing extended JSON: %v", err)
+					// Merge attributes: extended first, then main (main overrides)
+					for k, v := range extendedSchema.Attributes {
+						if _, exists := jsonSchemaData.Attributes[k]; !exists {
+							jsonSchemaData.Attributes[k] = v
+						}
+					}
+				}
+
+				// Apply the allowlist, reporting entries that no longer match so
+				// that the allowlist does not outlive the drift it describes.
+				for _, attrName := range version.omitted[protoMessageData.Name] {
+					if _, exists := protoMessageData.Fields[attrName]; exists {
+						errors = append(errors, fmt.Sprintf("Allowlisted attribute '%s' exists in Proto message '%s'. Remove it from the allowlist.", attrName, protoMessageData.Name))
+					}
+					if _, exists := jsonSchemaData.Attributes[attrName]; !exists {
+						errors = append(errors, fmt.Sprintf("Allowlisted attribute '%s' does not exist in JSON schema %s. Remove it from the allowlist.", attrName, jsonPath))
+					}
+					delete(jsonSchemaData.Attributes, attrName)
+				}
+				// The message was paired by caption, so only renamed messages
+				// differ from it; compare against the name the version declared.
+				jsonSchemaData.Caption = protoMessageData.Name
+
+				compareErrors, warnings := compareSchemas(jsonSchemaData, protoMessageData, dictionary)
+				for _, warn := range warnings {
+					GinkgoWriter.Printf("WARNING: %s\n", warn)
+				}
+				errors = append(errors, compareErrors...)
+				Expect(errors).To(BeEmpty(), "Errors: %v", errors)
+			})
+		}
+	}
+})
+
+func sortedFieldNames(message ProtoMessage) []string {
+	names := make([]string, 0, len(message.Fields))
+	for name := range message.Fields {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+var _ = Describe("Proto enum and JsonSchema enum synchronization", func() {
+	schemaRoot := "../../schema/"
+
+	type enumCase struct {
+		entityType string
+		fileName   string
+		attribute  string
+		protoFile  string
+		enum       string
+	}
+
+	cases := []enumCase{
+		{"objects", "locator.json", "type", "locator.proto", "LocatorType"},
+	}
+
+	for _, version := range protoVersions {
+		protoRoot := filepath.Join("../agntcy/oasf/types", version.dir)
+
+		for _, tc := range cases {
+			jsonPath := filepath.Join(schemaRoot, tc.entityType, tc.fileName)
+			protoPath := filepath.Join(protoRoot, tc.protoFile)
+			It(fmt.Sprintf("should sync the %s enum of %s and Proto %s/%s", tc.attribute, tc.fileName, version.dir, tc.enum), func() {
+				jsonSchemaData, err := parseJsonSchema(jsonPath)
+				Expect(err).NotTo(HaveOccurred(), "Error parsing JSON: %v", err)
+				attribute, ok := jsonSchemaData.Attributes[tc.attribute]
+				Expect(ok).To(BeTrue(), "Attribute '%s' not found in %s", tc.attribute, jsonPath)
+				Expect(attribute.Enum).NotTo(BeEmpty(), "Attribute '%s' in %s has no enum", tc.attribute, jsonPath)
+
+				protoMessageData, err := parseProtoFile(protoPath)
+				Expect(err).NotTo(HaveOccurred(), "Error parsing Proto: %v", err)
+				enum, ok := protoMessageData.Enums[tc.enum]
+				Expect(ok).To(BeTrue(), "Enum '%s' not found in %s", tc.enum, protoPath)
+
+				protoValues := enum.StringForms()
+				omitted := version.omittedEnumValues[tc.enum]
+				extra := version.extraEnumValues[tc.enum]
+
+				var errors []string
+				for value := range attribute.Enum {
+					switch {
+					case slices.Contains(protoValues, value) && slices.Contains(omitted, value):
+						errors = append(errors, fmt.Sprintf("Allowlisted enum value '%s' exists in Proto enum '%s'. Remove it from the allowlist.", value, tc.enum))
+					case !slices.Contains(protoValues, value) && !slices.Contains(omitted, value):
+						errors = append(errors, fmt.Sprintf("JSON enum value '%s' of attribute '%s' in %s is missing in Proto enum '%s' in %s.",
+							value, tc.attribute, jsonPath, tc.enum, protoPath))
+					}
+				}
+				for i, value := range protoValues {
+					_, exists := attribute.Enum[value]
+					switch {
+					case exists && slices.Contains(extra, value):
+						errors = append(errors, fmt.Sprintf("Allowlisted enum value '%s' exists in JSON schema %s. Remove it from the allowlist.", value, tc.fileName))
+					case !exists && !slices.Contains(extra, value):
+						errors = append(errors, fmt.Sprintf("Proto enum value '%s' (%s) of '%s' in %s is missing in the enum of attribute '%s' in %s.",
+							enum.Values[i].Name, value, tc.enum, protoPath, tc.attribute, jsonPath))
+					}
+				}
+				Expect(errors).To(BeEmpty(), "Errors: %v", errors)
+			})
+		}
 	}
 })
@@ -0,0 +1,13 @@
+package a2a_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestA2A(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "A2A Suite")
+}
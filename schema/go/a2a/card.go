@@ -0,0 +1,55 @@
+package a2a
+
+// AgentCard is an A2A AgentCard, as defined by the A2A specification v0.3.0.
+type AgentCard struct {
+	ProtocolVersion                   string                `json:"protocolVersion,omitempty"`
+	Name                              string                `json:"name"`
+	Description                       string                `json:"description"`
+	URL                               string                `json:"url"`
+	PreferredTransport                string                `json:"preferredTransport,omitempty"`
+	AdditionalInterfaces              []AgentInterface      `json:"additionalInterfaces,omitempty"`
+	IconURL                           string                `json:"iconUrl,omitempty"`
+	Provider                          *AgentProvider        `json:"provider,omitempty"`
+	Version                           string                `json:"version"`
+	DocumentationURL                  string                `json:"documentationUrl,omitempty"`
+	Capabilities                      AgentCapabilities     `json:"capabilities"`
+	SecuritySchemes                   map[string]any        `json:"securitySchemes,omitempty"`
+	Security                          []map[string][]string `json:"security,omitempty"`
+	DefaultInputModes                 []string              `json:"defaultInputModes"`
+	DefaultOutputModes                []string              `json:"defaultOutputModes"`
+	Skills                            []AgentSkill          `json:"skills"`
+	SupportsAuthenticatedExtendedCard bool                  `json:"supportsAuthenticatedExtendedCard,omitempty"`
+	Signatures                        []any                 `json:"signatures,omitempty"`
+}
+
+// AgentInterface is an additional transport endpoint of an agent.
+type AgentInterface struct {
+	URL       string `json:"url"`
+	Transport string `json:"transport"`
+}
+
+// AgentProvider is the organization providing an agent.
+type AgentProvider struct {
+	Organization string `json:"organization"`
+	URL          string `json:"url"`
+}
+
+// AgentCapabilities are the optional protocol features an agent supports.
+type AgentCapabilities struct {
+	Streaming              bool  `json:"streaming,omitempty"`
+	PushNotifications      bool  `json:"pushNotifications,omitempty"`
+	StateTransitionHistory bool  `json:"stateTransitionHistory,omitempty"`
+	Extensions             []any `json:"extensions,omitempty"`
+}
+
+// AgentSkill is a skill an agent declares in its card.
+type AgentSkill struct {
+	ID          string                `json:"id"`
+	Name        string                `json:"name"`
+	Description string                `json:"description"`
+	Tags        []string              `json:"tags"`
+	Examples    []string              `json:"examples,omitempty"`
+	InputModes  []string              `json:"inputModes,omitempty"`
+	OutputModes []string              `json:"outputModes,omitempty"`
+	Security    []map[string][]string `json:"security,omitempty"`
+}
@@ -0,0 +1,270 @@
+package a2a
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	typesv1 "github.com/agntcy/oasf/proto/go/agntcy/oasf/types/v1"
+	"github.com/agntcy/oasf/schema/go/moduledata"
+	"github.com/agntcy/oasf/schema/go/oasf"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// Defaults of the card fields a record does not describe.
+const (
+	DefaultTransport = "JSONRPC"
+	DefaultMode      = "text/plain"
+)
+
+// Guess is a card field the exporter could not take from the record.
+type Guess struct {
+	// Field is the JSON path of the card field, e.g. provider.url.
+	Field  string
+	Value  string
+	Reason string
+}
+
+func (g Guess) String() string {
+	return fmt.Sprintf("%s = %q: %s", g.Field, g.Value, g.Reason)
+}
+
+// ExportReport lists the card fields the exporter had to guess.
+type ExportReport struct {
+	Guesses []Guess
+}
+
+func (r *ExportReport) guess(field, value, format string, args ...any) {
+	r.Guesses = append(r.Guesses, Guess{Field: field, Value: value, Reason: fmt.Sprintf(format, args...)})
+}
+
+// Exporter converts records into AgentCards.
+type Exporter struct {
+	translator *oasf.Translator
+	skills     *skillMatcher
+	threshold  float64
+}
+
+// NewExporter returns an exporter describing record skills with the skill
+// classes of the schema. Of the options, only WithThreshold applies: it sets
+// the confidence a skill of the original card needs to describe a record skill.
+func NewExporter(schema *oasf.Schema, opts ...Option) *Exporter {
+	importer := NewImporter(schema, opts...)
+	return &Exporter{
+		translator: importer.translator,
+		skills:     importer.skills,
+		threshold:  importer.threshold,
+	}
+}
+
+// Export synthesizes an AgentCard from a record. The card of the record a2a
+// module, if any, provides the fields a record does not describe, such as
+// capabilities and security schemes, and the skills describing record skills.
+// The name, description, version, provider, endpoints and skills come from the
+// record; the url is taken from its url locators, or else its source_code
+// locators.
+func (e *Exporter) Export(record *typesv1.Record) (*AgentCard, *ExportReport, error) {
+	report := &ExportReport{}
+	card, err := e.originalCard(record)
+	if err != nil {
+		return nil, nil, err
+	}
+	protocolReason := "the card of the a2a module declares no protocolVersion"
+	if card == nil {
+		card = &AgentCard{}
+		protocolReason = "the record has no a2a module card"
+	}
+
+	card.Name = record.GetName()
+	card.Description = record.GetDescription()
+	card.Version = record.GetVersion()
+	if card.ProtocolVersion == "" {
+		card.ProtocolVersion = ProtocolVersion
+		report.guess("protocolVersion", card.ProtocolVersion, "%s", protocolReason)
+	}
+
+	urls := locatorURLs(record, moduledata.LocatorTypeURL)
+	if len(urls) == 0 {
+		if urls = locatorURLs(record, moduledata.LocatorTypeSourceCode); len(urls) > 0 {
+			report.guess("url", urls[0], "the record has no url locator, the source_code locator was used")
+		}
+	}
+	if len(urls) > 0 {
+		card.URL = urls[0]
+		e.exportInterfaces(card, urls, report)
+	}
+	if card.PreferredTransport == "" {
+		card.PreferredTransport = DefaultTransport
+		report.guess("preferredTransport", card.PreferredTransport, "the A2A default transport")
+	}
+
+	e.exportProvider(record, card, report)
+	if card.DefaultInputModes == nil {
+		card.DefaultInputModes = []string{DefaultMode}
+		report.guess("defaultInputModes", DefaultMode, "the record declares no input modes")
+	}
+	if card.DefaultOutputModes == nil {
+		card.DefaultOutputModes = []string{DefaultMode}
+		report.guess("defaultOutputModes", DefaultMode, "the record declares no output modes")
+	}
+	if err := e.exportSkills(record, card, report); err != nil {
+		return nil, nil, err
+	}
+
+	if err := checkCard(card); err != nil {
+		return nil, nil, fmt.Errorf("cannot export record '%s': %w", record.GetName(), err)
+	}
+	return card, report, nil
+}
+
+// ExportJSON synthesizes an AgentCard from a record and encodes it as JSON.
+func (e *Exporter) ExportJSON(record *typesv1.Record) ([]byte, *ExportReport, error) {
+	card, report, err := e.Export(record)
+	if err != nil {
+		return nil, nil, err
+	}
+	data, err := json.MarshalIndent(card, "", "  ")
+	if err != nil {
+		return nil, nil, err
+	}
+	return data, report, nil
+}
+
+// originalCard returns the card of the a2a module of the record, read from the
+// module artifact or the deprecated card_data attribute. The module is found by
+// its name, and its id, if set, must agree.
+func (e *Exporter) originalCard(record *typesv1.Record) (*AgentCard, error) {
+	a2a := e.translator.ByName(oasf.FamilyModule, ModuleName)
+	for _, module := range record.GetModules() {
+		if a2a == nil || module.GetName() == "" {
+			continue
+		}
+		if ref, err := e.translator.Resolve(oasf.FamilyModule, module.GetName(), int(module.GetId())); err != nil || ref != a2a {
+			continue
+		}
+		var data []byte
+		var err error
+		switch artifact := module.GetArtifact(); {
+		case len(artifact.GetData()) > 0:
+			data = artifact.GetData()
+		case artifact.GetJson() != nil:
+			data, err = protojson.Marshal(artifact.GetJson())
+		case module.GetData().GetFields()["card_data"].GetStructValue() != nil:
+			data, err = protojson.Marshal(module.GetData().GetFields()["card_data"])
+		default:
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		card := &AgentCard{}
+		if err := json.Unmarshal(data, card); err != nil {
+			return nil, fmt.Errorf("invalid AgentCard in module '%s': %w", module.GetName(), err)
+		}
+		if a2aData, err := moduledata.DecodeA2AData(module); err == nil && card.ProtocolVersion == "" {
+			card.ProtocolVersion = a2aData.CardSchemaVersion
+		}
+		return card, nil
+	}
+	return nil, nil
+}
+
+// exportInterfaces lists the locator urls as additional interfaces, keeping
+// the transports of the interfaces the original card declares. The card url is
+// only listed when the original card lists it.
+func (e *Exporter) exportInterfaces(card *AgentCard, urls []string, report *ExportReport) {
+	known := card.AdditionalInterfaces
+	card.AdditionalInterfaces = nil
+	for i, url := range urls {
+		if index := slices.IndexFunc(known, func(iface AgentInterface) bool { return iface.URL == url }); index >= 0 {
+			card.AdditionalInterfaces = append(card.AdditionalInterfaces, known[index])
+		} else if i > 0 {
+			card.AdditionalInterfaces = append(card.AdditionalInterfaces, AgentInterface{URL: url, Transport: DefaultTransport})
+			report.guess(fmt.Sprintf("additionalInterfaces[%d].transport", len(card.AdditionalInterfaces)-1),
+				DefaultTransport, "the locator does not tell the transport")
+		}
+	}
+}
+
+func (e *Exporter) exportProvider(record *typesv1.Record, card *AgentCard, report *ExportReport) {
+	if len(record.GetAuthors()) == 0 {
+		card.Provider = nil
+		return
+	}
+	organization := authorName(record.GetAuthors()[0])
+	if card.Provider != nil && card.Provider.Organization == organization && card.Provider.URL != "" {
+		return
+	}
+	card.Provider = &AgentProvider{Organization: organization}
+	if urls := locatorURLs(record, moduledata.LocatorTypeSourceCode); len(urls) > 0 {
+		card.Provider.URL = urls[0]
+		report.guess("provider.url", card.Provider.URL, "the source code of the agent")
+	} else {
+		card.Provider.URL = card.URL
+		report.guess("provider.url", card.Provider.URL, "the record has no source_code locator, the agent url was used")
+	}
+}
+
+// exportSkills keeps the skills of the original card that describe a record
+// skill and describes the other record skills with their skill class. The
+// record skills are resolved without filling in their missing name or id.
+func (e *Exporter) exportSkills(record *typesv1.Record, card *AgentCard, report *ExportReport) error {
+	var refs []*oasf.ClassRef
+	for _, skill := range record.GetSkills() {
+		ref, err := e.translator.Resolve(oasf.FamilySkill, skill.GetName(), int(skill.GetId()))
+		if err != nil {
+			return fmt.Errorf("cannot export skill '%s': %w", cmp.Or(skill.GetName(), fmt.Sprint(skill.GetId())), err)
+		}
+		if !slices.Contains(refs, ref) {
+			refs = append(refs, ref)
+		}
+	}
+
+	described := map[*oasf.ClassRef]bool{}
+	var skills []AgentSkill
+	for _, skill := range card.Skills {
+		match := e.skills.match(skill)
+		if match.Confidence >= e.threshold && slices.Contains(refs, match.Class) {
+			described[match.Class] = true
+			skills = append(skills, skill)
+		}
+	}
+	for _, ref := range refs {
+		if described[ref] {
+			continue
+		}
+		skills = append(skills, AgentSkill{
+			ID:          ref.Name,
+			Name:        ref.Class.Caption,
+			Description: ref.Class.Description,
+			Tags:        strings.Split(ref.Name, "/"),
+		})
+		report.guess(fmt.Sprintf("skills[%d]", len(skills)-1), ref.Name, "described by the skill class")
+	}
+	card.Skills = skills
+	if card.Skills == nil {
+		card.Skills = []AgentSkill{}
+	}
+	return nil
+}
+
+// locatorURLs returns the urls of the locators of a type.
+func locatorURLs(record *typesv1.Record, locatorType string) []string {
+	var urls []string
+	for _, locator := range record.GetLocators() {
+		if locator.GetType() == locatorType {
+			urls = append(urls, locator.GetUrls()...)
+		}
+	}
+	return urls
+}
+
+// authorName strips the email address of an author, e.g. "Jane Doe <jane@example.com>".
+func authorName(author string) string {
+	if name, _, found := strings.Cut(author, "<"); found {
+		return strings.TrimSpace(name)
+	}
+	return author
+}
@@ -0,0 +1,169 @@
+package a2a_test
+
+import (
+	"encoding/json"
+	"os"
+
+	typesv1 "github.com/agntcy/oasf/proto/go/agntcy/oasf/types/v1"
+	"github.com/agntcy/oasf/schema/go/a2a"
+	"github.com/agntcy/oasf/schema/go/oasf"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func guesses(report *a2a.ExportReport) []string {
+	var result []string
+	for _, guess := range report.Guesses {
+		result = append(result, guess.String())
+	}
+	return result
+}
+
+var _ = Describe("AgentCard export", func() {
+	var schema *oasf.Schema
+	var exporter *a2a.Exporter
+
+	BeforeEach(func() {
+		var err error
+		schema, err = oasf.Load(schemaDir)
+		Expect(err).NotTo(HaveOccurred())
+		exporter = a2a.NewExporter(schema)
+	})
+
+	newRecord := func() *typesv1.Record {
+		return &typesv1.Record{
+			Name:        "agntcy/docs-assistant",
+			Version:     "v2.0.0",
+			Description: "Summarizes technical documents.",
+			Authors:     []string{"Jane Doe <jane@example.com>"},
+			Locators: []*typesv1.Locator{
+				{Type: "source_code", Urls: []string{"https://github.com/agntcy/docs-assistant"}},
+				{Type: "url", Urls: []string{"https://docs-assistant.agntcy.org/a2a", "https://docs-assistant.agntcy.org/a2a/rest"}},
+			},
+			Skills: []*typesv1.Skill{{Id: 10302}},
+		}
+	}
+
+	It("should synthesize a card from a record", func() {
+		record := newRecord()
+		card, report, err := exporter.Export(record)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(record.GetSkills()[0].GetName()).To(BeEmpty(), "the record is left untouched")
+
+		summarization := schema.Class(oasf.FamilySkill, "summarization")
+		Expect(card).To(Equal(&a2a.AgentCard{
+			ProtocolVersion:      "0.3.0",
+			Name:                 "agntcy/docs-assistant",
+			Description:          "Summarizes technical documents.",
+			URL:                  "https://docs-assistant.agntcy.org/a2a",
+			PreferredTransport:   "JSONRPC",
+			AdditionalInterfaces: []a2a.AgentInterface{{URL: "https://docs-assistant.agntcy.org/a2a/rest", Transport: "JSONRPC"}},
+			Provider:             &a2a.AgentProvider{Organization: "Jane Doe", URL: "https://github.com/agntcy/docs-assistant"},
+			Version:              "v2.0.0",
+			DefaultInputModes:    []string{"text/plain"},
+			DefaultOutputModes:   []string{"text/plain"},
+			Skills: []a2a.AgentSkill{{
+				ID:          "language_processing/language_generation/summarization",
+				Name:        summarization.Caption,
+				Description: summarization.Description,
+				Tags:        []string{"language_processing", "language_generation", "summarization"},
+			}},
+		}))
+		Expect(guesses(report)).To(Equal([]string{
+			`protocolVersion = "0.3.0": the record has no a2a module card`,
+			`additionalInterfaces[0].transport = "JSONRPC": the locator does not tell the transport`,
+			`preferredTransport = "JSONRPC": the A2A default transport`,
+			`provider.url = "https://github.com/agntcy/docs-assistant": the source code of the agent`,
+			`defaultInputModes = "text/plain": the record declares no input modes`,
+			`defaultOutputModes = "text/plain": the record declares no output modes`,
+			`skills[0] = "language_processing/language_generation/summarization": described by the skill class`,
+		}))
+	})
+
+	It("should fall back to the source_code locator for the url", func() {
+		record := newRecord()
+		record.Locators = record.Locators[:1]
+		record.Authors = nil
+		card, report, err := exporter.Export(record)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(card.URL).To(Equal("https://github.com/agntcy/docs-assistant"))
+		Expect(card.Provider).To(BeNil())
+		Expect(guesses(report)).To(ContainElement(
+			`url = "https://github.com/agntcy/docs-assistant": the record has no url locator, the source_code locator was used`,
+		))
+	})
+
+	It("should restore the card of an imported record", func() {
+		original, err := os.ReadFile(cardPath)
+		Expect(err).NotTo(HaveOccurred())
+		record, _, err := a2a.NewImporter(schema).Import(original)
+		Expect(err).NotTo(HaveOccurred())
+
+		card, report, err := exporter.Export(record)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(report.Guesses).To(BeEmpty())
+
+		expected := &a2a.AgentCard{}
+		Expect(json.Unmarshal(original, expected)).To(Succeed())
+		// The weather skill was not mapped onto a skill class.
+		expected.Skills = expected.Skills[:3]
+		Expect(card).To(Equal(expected))
+
+		// Record skills the card does not describe are added.
+		record.Skills = append(record.Skills, &typesv1.Skill{Name: "software_engineering/code_quality/code_refactoring"})
+		card, report, err = exporter.Export(record)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(card.Skills).To(HaveLen(4))
+		Expect(card.Skills[3].ID).To(Equal("software_engineering/code_quality/code_refactoring"))
+		Expect(guesses(report)).To(Equal([]string{
+			`skills[3] = "software_engineering/code_quality/code_refactoring": described by the skill class`,
+		}))
+	})
+
+	It("should guess the protocolVersion the card of the a2a module lacks", func() {
+		record := newRecord()
+		record.Modules = []*typesv1.Module{{
+			Name:     "integration/a2a",
+			Id:       203,
+			Artifact: &typesv1.Descriptor{MediaType: "application/json", Data: []byte(`{"name": "docs-assistant"}`)},
+		}}
+		card, report, err := exporter.Export(record)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(card.ProtocolVersion).To(Equal("0.3.0"))
+		Expect(guesses(report)).To(ContainElement(
+			`protocolVersion = "0.3.0": the card of the a2a module declares no protocolVersion`,
+		))
+	})
+
+	It("should ignore modules whose name and id disagree", func() {
+		record := newRecord()
+		record.Modules = []*typesv1.Module{{
+			Name:     "integration/mcp",
+			Id:       203,
+			Artifact: &typesv1.Descriptor{MediaType: "application/json", Data: []byte(`{"name": `)},
+		}}
+		_, report, err := exporter.Export(record)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(guesses(report)).To(ContainElement(`protocolVersion = "0.3.0": the record has no a2a module card`))
+	})
+
+	It("should encode the card as JSON", func() {
+		data, _, err := exporter.ExportJSON(newRecord())
+		Expect(err).NotTo(HaveOccurred())
+		_, _, err = a2a.NewImporter(schema).Import(data)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should reject records that cannot make a valid card", func() {
+		record := newRecord()
+		record.Locators = nil
+		record.Description = ""
+		_, _, err := exporter.Export(record)
+		Expect(err).To(MatchError("cannot export record 'agntcy/docs-assistant': invalid AgentCard: description is required\nurl is required"))
+
+		record = newRecord()
+		record.Skills = []*typesv1.Skill{{Name: "unknown_skill"}}
+		_, _, err = exporter.Export(record)
+		Expect(err).To(MatchError(ContainSubstring("cannot export skill 'unknown_skill'")))
+	})
+})
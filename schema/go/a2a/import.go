@@ -0,0 +1,218 @@
+// Package a2a converts between A2A AgentCards (v0.3.0) and OASF records.
+package a2a
+
+import (
+	"cmp"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	typesv1 "github.com/agntcy/oasf/proto/go/agntcy/oasf/types/v1"
+	"github.com/agntcy/oasf/schema/go/moduledata"
+	"github.com/agntcy/oasf/schema/go/oasf"
+)
+
+const (
+	// ModuleName is the class name of the A2A module.
+	ModuleName = "a2a"
+	// ProtocolVersion is the A2A protocol version of the supported cards.
+	ProtocolVersion = "0.3.0"
+	// ArtifactType is the artifact type of the AgentCard module artifact.
+	ArtifactType = "application/vnd.a2a.agent-card+json"
+	// DefaultThreshold is the default confidence a card skill needs to be
+	// mapped onto a skill class.
+	DefaultThreshold = 0.5
+)
+
+// Report describes how the skills of a card were mapped onto skill classes.
+type Report struct {
+	Skills []SkillMatch
+}
+
+// Unmatched returns the card skills that were not mapped onto a skill class.
+func (r *Report) Unmatched() []SkillMatch {
+	var unmatched []SkillMatch
+	for _, match := range r.Skills {
+		if !match.Matched {
+			unmatched = append(unmatched, match)
+		}
+	}
+	return unmatched
+}
+
+// Option configures an Importer.
+type Option func(*Importer)
+
+// WithThreshold sets the confidence a card skill needs to be mapped onto a
+// skill class. It defaults to DefaultThreshold.
+func WithThreshold(threshold float64) Option {
+	return func(i *Importer) {
+		i.threshold = threshold
+	}
+}
+
+// WithClock sets the function returning the creation time of the records. It
+// defaults to time.Now.
+func WithClock(now func() time.Time) Option {
+	return func(i *Importer) {
+		i.now = now
+	}
+}
+
+// Importer converts AgentCards into records of a schema.
+type Importer struct {
+	schema     *oasf.Schema
+	translator *oasf.Translator
+	skills     *skillMatcher
+	threshold  float64
+	now        func() time.Time
+}
+
+// NewImporter returns an importer mapping card skills onto the skill classes
+// of the schema.
+func NewImporter(schema *oasf.Schema, opts ...Option) *Importer {
+	translator := oasf.NewTranslator(schema)
+	i := &Importer{
+		schema:     schema,
+		translator: translator,
+		skills:     newSkillMatcher(schema, translator),
+		threshold:  DefaultThreshold,
+		now:        time.Now,
+	}
+	for _, opt := range opts {
+		opt(i)
+	}
+	return i
+}
+
+// ImportFile converts an AgentCard file.
+func (i *Importer) ImportFile(path string) (*typesv1.Record, *Report, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	record, report, err := i.Import(data)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return record, report, nil
+}
+
+// Import converts an AgentCard into a record with an a2a module holding the
+// card as its artifact, and skills mapped from the card skills.
+func (i *Importer) Import(data []byte) (*typesv1.Record, *Report, error) {
+	var card AgentCard
+	if err := json.Unmarshal(data, &card); err != nil {
+		return nil, nil, fmt.Errorf("invalid AgentCard: %w", err)
+	}
+	if err := checkCard(&card); err != nil {
+		return nil, nil, err
+	}
+
+	record := &typesv1.Record{
+		Name:          card.Name,
+		Version:       card.Version,
+		SchemaVersion: i.schema.Version,
+		Description:   card.Description,
+		CreatedAt:     i.now().UTC().Format(time.RFC3339),
+		Locators:      []*typesv1.Locator{{Type: moduledata.LocatorTypeURL, Urls: cardURLs(&card)}},
+	}
+	if card.Provider != nil && card.Provider.Organization != "" {
+		record.Authors = []string{card.Provider.Organization}
+	}
+
+	report := &Report{}
+	for _, skill := range card.Skills {
+		match := i.skills.match(skill)
+		match.Matched = match.Class != nil && match.Confidence >= i.threshold
+		report.Skills = append(report.Skills, match)
+		if match.Matched && !slices.ContainsFunc(record.Skills, func(s *typesv1.Skill) bool { return s.GetName() == match.Class.Name }) {
+			record.Skills = append(record.Skills, &typesv1.Skill{Name: match.Class.Name, Id: uint32(match.Class.ID)})
+		}
+	}
+
+	module, err := i.module(&card, data)
+	if err != nil {
+		return nil, nil, err
+	}
+	record.Modules = []*typesv1.Module{module}
+	return record, report, nil
+}
+
+func (i *Importer) module(card *AgentCard, data []byte) (*typesv1.Module, error) {
+	ref := i.translator.ByName(oasf.FamilyModule, ModuleName)
+	if ref == nil {
+		return nil, fmt.Errorf("the schema defines no %s module", ModuleName)
+	}
+	sum := sha256.Sum256(data)
+	module := &typesv1.Module{
+		Name: ref.Name,
+		Id:   uint32(ref.ID),
+		Artifact: &typesv1.Descriptor{
+			MediaType:    "application/json",
+			ArtifactType: ArtifactType,
+			Size:         uint64(len(data)),
+			Digest:       "sha256:" + hex.EncodeToString(sum[:]),
+			Data:         slices.Clone(data),
+		},
+	}
+	// The schema still requires the deprecated card_data, a copy of the card.
+	var cardData map[string]any
+	if err := json.Unmarshal(data, &cardData); err != nil {
+		return nil, err
+	}
+	a2aData := &moduledata.A2AData{
+		CardSchemaVersion: cmp.Or(card.ProtocolVersion, ProtocolVersion),
+		CardData:          cardData,
+	}
+	if err := moduledata.EncodeA2AData(module, a2aData); err != nil {
+		return nil, err
+	}
+	return module, nil
+}
+
+// checkCard reports the missing required fields of a card and unsupported
+// protocol versions.
+func checkCard(card *AgentCard) error {
+	var errs []error
+	if card.ProtocolVersion != "" && !strings.HasPrefix(card.ProtocolVersion, "0.3.") {
+		errs = append(errs, fmt.Errorf("unsupported protocolVersion %q, expected %s", card.ProtocolVersion, ProtocolVersion))
+	}
+	for _, field := range []struct{ name, value string }{
+		{"name", card.Name},
+		{"description", card.Description},
+		{"url", card.URL},
+		{"version", card.Version},
+	} {
+		if field.value == "" {
+			errs = append(errs, fmt.Errorf("%s is required", field.name))
+		}
+	}
+	for i, skill := range card.Skills {
+		if skill.ID == "" && skill.Name == "" {
+			errs = append(errs, fmt.Errorf("skills[%d]: id or name is required", i))
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("invalid AgentCard: %w", err)
+	}
+	return nil
+}
+
+// cardURLs returns the url of the card followed by the distinct urls of its
+// additional interfaces.
+func cardURLs(card *AgentCard) []string {
+	urls := []string{card.URL}
+	for _, iface := range card.AdditionalInterfaces {
+		if iface.URL != "" && !slices.Contains(urls, iface.URL) {
+			urls = append(urls, iface.URL)
+		}
+	}
+	return urls
+}
@@ -0,0 +1,154 @@
+package a2a_test
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	typesv1 "github.com/agntcy/oasf/proto/go/agntcy/oasf/types/v1"
+	"github.com/agntcy/oasf/proto/go/descriptor"
+	"github.com/agntcy/oasf/schema/go/a2a"
+	"github.com/agntcy/oasf/schema/go/moduledata"
+	"github.com/agntcy/oasf/schema/go/oasf"
+	"github.com/agntcy/oasf/schema/go/validator"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const schemaDir = "../.."
+
+var (
+	cardPath  = filepath.Join("testdata", "agent_card.json")
+	createdAt = time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+)
+
+func skillNames(record *typesv1.Record) []string {
+	var names []string
+	for _, skill := range record.GetSkills() {
+		names = append(names, skill.GetName())
+	}
+	return names
+}
+
+func recordMap(record *typesv1.Record) map[string]any {
+	data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(record)
+	Expect(err).NotTo(HaveOccurred())
+	var result map[string]any
+	Expect(json.Unmarshal(data, &result)).To(Succeed())
+	// protojson encodes 64-bit integers as strings, the schema expects numbers.
+	for i, module := range record.GetModules() {
+		if module.GetArtifact() != nil {
+			artifact := result["modules"].([]any)[i].(map[string]any)["artifact"].(map[string]any)
+			artifact["size"] = module.GetArtifact().GetSize()
+		}
+	}
+	return result
+}
+
+var _ = Describe("AgentCard import", func() {
+	var schema *oasf.Schema
+
+	BeforeEach(func() {
+		var err error
+		schema, err = oasf.Load(schemaDir)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	newImporter := func(opts ...a2a.Option) *a2a.Importer {
+		return a2a.NewImporter(schema, append(opts, a2a.WithClock(func() time.Time { return createdAt }))...)
+	}
+
+	It("should convert the card into a record", func() {
+		record, _, err := newImporter().ImportFile(cardPath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(record.GetName()).To(Equal("Docs Assistant"))
+		Expect(record.GetVersion()).To(Equal("1.4.0"))
+		Expect(record.GetSchemaVersion()).To(Equal(schema.Version))
+		Expect(record.GetDescription()).To(Equal("Summarizes, translates and reviews technical documents."))
+		Expect(record.GetAuthors()).To(Equal([]string{"AGNTCY"}))
+		Expect(record.GetCreatedAt()).To(Equal("2025-06-01T12:00:00Z"))
+		Expect(record.GetLocators()).To(HaveLen(1))
+		Expect(record.GetLocators()[0].GetType()).To(Equal("url"))
+		Expect(record.GetLocators()[0].GetUrls()).To(Equal([]string{
+			"https://docs-assistant.agntcy.org/a2a/v1",
+			"https://docs-assistant.agntcy.org/a2a/grpc",
+		}))
+	})
+
+	It("should map the card skills onto skill classes", func() {
+		record, report, err := newImporter().ImportFile(cardPath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(skillNames(record)).To(Equal([]string{
+			"language_processing/language_generation/summarization",
+			"language_processing/language_translation/translation",
+			"software_engineering/code_quality/code_review",
+		}))
+		id, ok := schema.ClassID(oasf.FamilySkill, "code_review")
+		Expect(ok).To(BeTrue())
+		Expect(record.GetSkills()[2].GetId()).To(BeEquivalentTo(id))
+		Expect(report.Skills).To(HaveLen(4))
+		Expect(report.Skills[0].Confidence).To(BeNumerically(">=", a2a.DefaultThreshold))
+		Expect(report.Skills[2].Confidence).To(BeEquivalentTo(1), "the skill id is the class name")
+
+		unmatched := report.Unmatched()
+		Expect(unmatched).To(HaveLen(1))
+		Expect(unmatched[0].Skill.ID).To(Equal("weather"))
+		Expect(unmatched[0].Class).To(BeNil())
+		Expect(unmatched[0].Confidence).To(BeZero())
+	})
+
+	It("should report the best candidate of skills below the threshold", func() {
+		record, report, err := newImporter(a2a.WithThreshold(0.9)).ImportFile(cardPath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(skillNames(record)).To(Equal([]string{"software_engineering/code_quality/code_review"}))
+
+		unmatched := report.Unmatched()
+		Expect(unmatched).To(HaveLen(3))
+		Expect(unmatched[0].Skill.ID).To(Equal("summarize"))
+		Expect(unmatched[0].Class.Name).To(Equal("language_processing/language_generation/summarization"))
+		Expect(unmatched[0].Confidence).To(BeNumerically("<", 0.9))
+	})
+
+	It("should store the card as the a2a module artifact", func() {
+		original, err := os.ReadFile(cardPath)
+		Expect(err).NotTo(HaveOccurred())
+		record, _, err := newImporter().Import(original)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(record.GetModules()).To(HaveLen(1))
+
+		module := record.GetModules()[0]
+		Expect(module.GetName()).To(Equal("integration/a2a"))
+		Expect(module.GetId()).To(BeEquivalentTo(203))
+		Expect(module.GetArtifact().GetData()).To(Equal(original))
+		Expect(module.GetArtifact().GetArtifactType()).To(Equal(a2a.ArtifactType))
+		Expect(descriptor.NewVerifier().Verify(context.Background(), module.GetArtifact())).To(Succeed())
+
+		data, err := moduledata.DecodeA2AData(module)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(data.CardSchemaVersion).To(Equal("0.3.0"))
+		Expect(data.CardData).To(HaveKeyWithValue("name", "Docs Assistant"))
+	})
+
+	It("should produce records valid against the schema", func() {
+		record, _, err := newImporter().ImportFile(cardPath)
+		Expect(err).NotTo(HaveOccurred())
+		result := validator.New(schema).Validate(recordMap(record))
+		Expect(result.Errors).To(BeEmpty())
+	})
+
+	It("should reject invalid cards", func() {
+		_, _, err := newImporter().Import([]byte(`{"protocolVersion": "0.2.5", "skills": [{"tags": []}]}`))
+		Expect(err).To(MatchError(`invalid AgentCard: unsupported protocolVersion "0.2.5", expected 0.3.0
+name is required
+description is required
+url is required
+version is required
+skills[0]: id or name is required`))
+
+		_, _, err = newImporter().Import([]byte(`[]`))
+		Expect(err).To(MatchError(ContainSubstring("invalid AgentCard")))
+	})
+})
@@ -0,0 +1,164 @@
+package a2a
+
+import (
+	"cmp"
+	"path"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/agntcy/oasf/schema/go/oasf"
+)
+
+// SkillMatch is the skill class a card skill was mapped onto.
+type SkillMatch struct {
+	Skill AgentSkill
+	// Class is the best matching skill class, nil when no class shares a term
+	// with the skill.
+	Class *oasf.ClassRef
+	// Confidence ranges from 0 to 1; 1 means the skill id or name is the class
+	// name.
+	Confidence float64
+	// Matched reports whether the confidence reached the threshold, in which
+	// case the class was added to the record skills.
+	Matched bool
+}
+
+// stopWords are ignored when comparing skills with skill classes.
+var stopWords = map[string]bool{
+	"a": true, "an": true, "and": true, "any": true, "as": true, "at": true, "by": true,
+	"for": true, "from": true, "in": true, "into": true, "is": true, "it": true, "its": true,
+	"of": true, "on": true, "or": true, "the": true, "to": true, "using": true, "with": true,
+	"your": true,
+}
+
+// suffixes are stripped from terms, longest first, so that inflections of a
+// word compare equal.
+var suffixes = []string{"ization", "isation", "ations", "ation", "ition", "ments", "ment", "ings", "ing", "ions", "ion", "ers", "ies", "er", "es", "ed", "ize", "ise", "e", "s"}
+
+// terms returns the stemmed words of the texts.
+func terms(texts ...string) []string {
+	var result []string
+	for _, text := range texts {
+		words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		})
+		for _, word := range words {
+			if len(word) < 2 || stopWords[word] {
+				continue
+			}
+			if term := stem(word); !slices.Contains(result, term) {
+				result = append(result, term)
+			}
+		}
+	}
+	return result
+}
+
+func stem(word string) string {
+	for _, suffix := range suffixes {
+		if stem, ok := strings.CutSuffix(word, suffix); ok && len(stem) >= 3 {
+			return stem
+		}
+	}
+	return word
+}
+
+// similar reports whether two terms are the same, or one is a long enough
+// prefix of the other to be the same word, e.g. summar and summari.
+func similar(a, b string) bool {
+	if len(a) > len(b) {
+		a, b = b, a
+	}
+	return a == b || len(a) >= 6 && strings.HasPrefix(b, a)
+}
+
+func matchCount(terms, candidates []string) int {
+	count := 0
+	for _, term := range terms {
+		if slices.ContainsFunc(candidates, func(candidate string) bool { return similar(term, candidate) }) {
+			count++
+		}
+	}
+	return count
+}
+
+// skillClass is a skill class with its terms.
+type skillClass struct {
+	ref *oasf.ClassRef
+	// key holds the terms of the class name and caption.
+	key []string
+	// all also holds the terms of the class description.
+	all []string
+}
+
+// skillMatcher scores card skills against every skill class of a schema.
+type skillMatcher struct {
+	classes []skillClass
+}
+
+func newSkillMatcher(schema *oasf.Schema, translator *oasf.Translator) *skillMatcher {
+	m := &skillMatcher{}
+	classes := schema.Classes(oasf.FamilySkill)
+	names := make([]string, 0, len(classes))
+	for name := range classes {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		ref := translator.ByName(oasf.FamilySkill, name)
+		if ref == nil || ref.ID == 0 {
+			continue
+		}
+		key := terms(strings.ReplaceAll(name, "_", " "), ref.Class.Caption)
+		m.classes = append(m.classes, skillClass{
+			ref: ref,
+			key: key,
+			all: append(slices.Clone(key), terms(ref.Class.Description)...),
+		})
+	}
+	return m
+}
+
+// match returns the best matching class of a card skill. The confidence is the
+// harmonic mean of the share of the class name and caption terms found in the
+// skill, and of the share of the skill id, name and tag terms found in the
+// class. Ties go to the most specific class.
+func (m *skillMatcher) match(skill AgentSkill) SkillMatch {
+	best := SkillMatch{Skill: skill}
+	for _, name := range []string{skill.ID, skill.Name} {
+		normalized := strings.Join(strings.Fields(strings.ToLower(name)), "_")
+		for _, class := range m.classes {
+			if normalized != "" && (normalized == class.ref.Class.Name || normalized == class.ref.Name) {
+				best.Class, best.Confidence = class.ref, 1
+				return best
+			}
+		}
+	}
+
+	key := terms(append([]string{skill.ID, skill.Name}, skill.Tags...)...)
+	all := append(slices.Clone(key), terms(skill.Description)...)
+	if len(key) == 0 {
+		return best
+	}
+	for _, class := range m.classes {
+		recall := float64(matchCount(class.key, all)) / float64(len(class.key))
+		precision := float64(matchCount(key, class.all)) / float64(len(key))
+		if recall == 0 || precision == 0 {
+			continue
+		}
+		confidence := 2 * recall * precision / (recall + precision)
+		if best.Class == nil || confidence > best.Confidence ||
+			confidence == best.Confidence && moreSpecific(class.ref, best.Class) {
+			best.Class, best.Confidence = class.ref, confidence
+		}
+	}
+	return best
+}
+
+func moreSpecific(a, b *oasf.ClassRef) bool {
+	if c := cmp.Compare(strings.Count(a.Name, "/"), strings.Count(b.Name, "/")); c != 0 {
+		return c > 0
+	}
+	return path.Base(a.Name) < path.Base(b.Name)
+}
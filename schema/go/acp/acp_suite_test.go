@@ -0,0 +1,13 @@
+package acp_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestACP(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "ACP Suite")
+}
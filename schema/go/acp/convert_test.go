@@ -0,0 +1,232 @@
+package acp_test
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	typesv1 "github.com/agntcy/oasf/proto/go/agntcy/oasf/types/v1"
+	"github.com/agntcy/oasf/proto/go/descriptor"
+	"github.com/agntcy/oasf/schema/go/acp"
+	"github.com/agntcy/oasf/schema/go/moduledata"
+	"github.com/agntcy/oasf/schema/go/oasf"
+	"github.com/agntcy/oasf/schema/go/validator"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const schemaDir = "../.."
+
+var manifestPath = filepath.Join("testdata", "manifest.json")
+
+func readManifest() []byte {
+	data, err := os.ReadFile(manifestPath)
+	Expect(err).NotTo(HaveOccurred())
+	return data
+}
+
+func toJSON(value any) string {
+	data, err := json.Marshal(value)
+	Expect(err).NotTo(HaveOccurred())
+	return string(data)
+}
+
+var _ = Describe("ACP manifest conversion", func() {
+	var (
+		schema   *oasf.Schema
+		importer *acp.Importer
+		exporter *acp.Exporter
+	)
+
+	BeforeEach(func() {
+		var err error
+		schema, err = oasf.Load(schemaDir)
+		Expect(err).NotTo(HaveOccurred())
+		importer = acp.NewImporter(schema)
+		exporter = acp.NewExporter(schema)
+	})
+
+	It("should map the manifest onto the module data", func() {
+		module, err := importer.ImportFile(manifestPath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(module.GetName()).To(Equal("integration/acp"))
+		Expect(module.GetId()).To(BeEquivalentTo(201))
+
+		data, err := moduledata.DecodeACPManifestData(module)
+		Expect(err).NotTo(HaveOccurred())
+		yes, no := true, false
+		Expect(data.ACP.Capabilities).To(Equal(&moduledata.ACPCapabilities{
+			Threads:          &yes,
+			InterruptSupport: &yes,
+			Callbacks:        &no,
+			Streaming:        &moduledata.ACPStreamingModes{ResultStreaming: &yes, CustomObjectsStreaming: &no},
+		}))
+		Expect(data.ACP.CustomStreamingUpdate).To(BeNil())
+		Expect(data.ACP.Interrupts).To(HaveLen(1))
+		Expect(data.ACP.Interrupts[0].InterruptType).To(Equal("approval"))
+		Expect(data.Deployment.DeploymentOptions).To(HaveLen(3))
+		Expect(data.Deployment.EnvVars).To(Equal([]moduledata.EnvVar{
+			{Name: "AZURE_OPENAI_API_KEY", Description: "Environment variable for Azure OpenAI API key", Required: &yes},
+			{Name: "AZURE_OPENAI_MODEL", Description: "Azure OpenAI deployment to use", DefaultValue: "gpt-4o"},
+		}))
+		Expect(data.Deployment.AgentDeps).To(Equal([]moduledata.ACPAgentDependency{
+			{
+				Name: "email_reviewer",
+				Ref: &moduledata.ACPAgentReference{
+					Name:    "org.agntcy.email_reviewer",
+					Version: "0.0.2",
+					Locator: &moduledata.Locator{
+						Type: "url",
+						URLs: []string{"https://github.com/agntcy/acp-sdk/blob/main/examples/email_reviewer/deploy/email_reviewer.json"},
+					},
+				},
+				DeploymentOption: "source_code_local",
+				EnvVarValues: []moduledata.EnvVarValues{{
+					Name: "email_reviewer",
+					Values: []moduledata.KeyValueObject{
+						{Name: "AZURE_OPENAI_API_VERSION", Value: "2024-08-01-preview"},
+						{Name: "AZURE_OPENAI_MODEL", Value: "gpt-4o-mini"},
+					},
+					EnvDeps: []moduledata.EnvVarValues{{
+						Name:   "spell_checker",
+						Values: []moduledata.KeyValueObject{{Name: "LANGUAGE", Value: "en"}},
+					}},
+				}},
+			},
+			{
+				Name: "translator",
+				Ref:  &moduledata.ACPAgentReference{Name: "org.agntcy.translator", Version: "1.0.0"},
+			},
+		}))
+	})
+
+	It("should store the manifest as the module artifact", func() {
+		module, err := importer.Import(readManifest())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(module.GetArtifact().GetArtifactType()).To(Equal(acp.ArtifactType))
+		Expect(module.GetArtifact().GetData()).To(Equal(readManifest()))
+		Expect(descriptor.NewVerifier().Verify(context.Background(), module.GetArtifact())).To(Succeed())
+	})
+
+	It("should produce module data valid against the schema", func() {
+		module, err := importer.Import(readManifest())
+		Expect(err).NotTo(HaveOccurred())
+		encoded, err := protojson.Marshal(module.GetData())
+		Expect(err).NotTo(HaveOccurred())
+		var data map[string]any
+		Expect(json.Unmarshal(encoded, &data)).To(Succeed())
+
+		Expect(validator.New(schema).ValidateObject("acp_manifest_data", data).Errors).To(BeEmpty())
+	})
+
+	It("should export the imported manifest without loss", func() {
+		module, err := importer.Import(readManifest())
+		Expect(err).NotTo(HaveOccurred())
+		manifest, err := exporter.Export(module)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(toJSON(manifest)).To(MatchJSON(readManifest()))
+
+		// Specs and deployment are restored from the module data alone.
+		module.Artifact = nil
+		manifest, err = exporter.Export(module)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(manifest.Metadata).To(Equal(acp.Metadata{}))
+		var original acp.Manifest
+		Expect(json.Unmarshal(readManifest(), &original)).To(Succeed())
+		Expect(toJSON(manifest.Specs)).To(MatchJSON(toJSON(original.Specs)))
+		Expect(toJSON(manifest.Deployment)).To(MatchJSON(toJSON(original.Deployment)))
+	})
+
+	It("should keep the fields the manifest types do not declare", func() {
+		original, err := os.ReadFile(filepath.Join("testdata", "manifest_extra.json"))
+		Expect(err).NotTo(HaveOccurred())
+		module, err := importer.Import(original)
+		Expect(err).NotTo(HaveOccurred())
+		manifest, err := exporter.Export(module)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(toJSON(manifest)).To(MatchJSON(original))
+
+		// Values set from the module data take precedence.
+		data, err := moduledata.DecodeACPManifestData(module)
+		Expect(err).NotTo(HaveOccurred())
+		yes := true
+		data.ACP.Capabilities.Threads = &yes
+		Expect(moduledata.EncodeACPManifestData(module, data)).To(Succeed())
+		manifest, err = exporter.Export(module)
+		Expect(err).NotTo(HaveOccurred())
+		encoded := toJSON(manifest)
+		Expect(encoded).To(ContainSubstring(`"capabilities":{"extra":{"batching":true},"threads":true}`))
+		Expect(encoded).To(ContainSubstring(`"tags":["demo","echo"]`))
+	})
+
+	It("should import the exported module data without loss", func() {
+		module, err := importer.Import(readManifest())
+		Expect(err).NotTo(HaveOccurred())
+		data, err := moduledata.DecodeACPManifestData(module)
+		Expect(err).NotTo(HaveOccurred())
+
+		manifest, err := acp.FromModuleData(data)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(acp.ModuleData(manifest)).To(Equal(data))
+	})
+
+	It("should export the module of a record", func() {
+		module, err := importer.Import(readManifest())
+		Expect(err).NotTo(HaveOccurred())
+		module.Artifact = nil
+		record := &typesv1.Record{
+			Name:        "agntcy/mailcomposer",
+			Version:     "v0.0.1",
+			Description: "Composes marketing emails.",
+			Modules:     []*typesv1.Module{module},
+		}
+		manifest, err := exporter.ExportRecord(record)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(manifest.Metadata).To(Equal(acp.Metadata{
+			Ref:         acp.AgentRef{Name: "agntcy/mailcomposer", Version: "v0.0.1"},
+			Description: "Composes marketing emails.",
+		}))
+
+		// The module is found by its name, its id alone does not do.
+		module.Id = 0
+		_, err = exporter.ExportRecord(record)
+		Expect(err).NotTo(HaveOccurred())
+		module.Name, module.Id = "integration/a2a", 201
+		_, err = exporter.ExportRecord(record)
+		Expect(err).To(MatchError("record 'agntcy/mailcomposer' has no integration/acp module"))
+
+		record.Modules = nil
+		_, err = exporter.ExportRecord(record)
+		Expect(err).To(MatchError("record 'agntcy/mailcomposer' has no integration/acp module"))
+
+		_, err = acp.NewExporter(&oasf.Schema{}).ExportRecord(record)
+		Expect(err).To(MatchError("the schema defines no acp module"))
+		_, err = acp.NewImporter(&oasf.Schema{}).Import(readManifest())
+		Expect(err).To(MatchError("the schema defines no acp module"))
+	})
+
+	It("should reject invalid manifests", func() {
+		_, err := importer.Import([]byte(`{"specs": {"input": {}, "output": {}}, "deployment": {"deployment_options": [{"type": "helm"}]}}`))
+		Expect(err).To(MatchError("invalid ACP manifest: metadata.ref.name is required\n" +
+			"metadata.ref.version is required\n" +
+			"specs.config is required\n" +
+			"deployment.deployment_options[0]: unknown type helm"))
+	})
+
+	It("should reject module data a manifest cannot hold", func() {
+		module, err := importer.Import(readManifest())
+		Expect(err).NotTo(HaveOccurred())
+		data, err := moduledata.DecodeACPManifestData(module)
+		Expect(err).NotTo(HaveOccurred())
+
+		data.Deployment.AgentDeps[0].EnvVarValues = append(data.Deployment.AgentDeps[0].EnvVarValues, moduledata.EnvVarValues{})
+		_, err = acp.FromModuleData(data)
+		Expect(err).To(MatchError("agent_deps[0]: a manifest dependency holds a single env_var_values"))
+
+		data.ACP.Input = "text"
+		_, err = acp.FromModuleData(data)
+		Expect(err).To(MatchError("input is not a schema object"))
+	})
+})
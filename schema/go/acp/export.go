@@ -0,0 +1,206 @@
+package acp
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+
+	typesv1 "github.com/agntcy/oasf/proto/go/agntcy/oasf/types/v1"
+	"github.com/agntcy/oasf/schema/go/moduledata"
+	"github.com/agntcy/oasf/schema/go/oasf"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// Exporter converts ACP modules of a schema into manifests.
+type Exporter struct {
+	translator *oasf.Translator
+}
+
+// NewExporter returns an exporter finding the ACP module of records through
+// the schema.
+func NewExporter(schema *oasf.Schema) *Exporter {
+	return &Exporter{translator: oasf.NewTranslator(schema)}
+}
+
+// Export converts an integration/acp module into a manifest. The specs and
+// deployment come from the module data, the metadata and the fields the module
+// data has no place for from the manifest stored as the module artifact, if
+// any.
+func (e *Exporter) Export(module *typesv1.Module) (*Manifest, error) {
+	data, err := moduledata.DecodeACPManifestData(module)
+	if err != nil {
+		return nil, err
+	}
+	manifest, err := FromModuleData(data)
+	if err != nil {
+		return nil, fmt.Errorf("cannot export module '%s': %w", module.GetName(), err)
+	}
+	original, err := artifactManifest(module.GetArtifact())
+	if err != nil {
+		return nil, fmt.Errorf("cannot export module '%s': %w", module.GetName(), err)
+	}
+	if original != nil {
+		manifest.Metadata = original.Metadata
+		manifest.unknown = original.unknown
+	}
+	return manifest, nil
+}
+
+// ExportRecord converts the integration/acp module of a record into a
+// manifest. The module is found by its name, and its id, if set, must agree.
+// The metadata the module artifact does not provide is taken from the record
+// name, version and description.
+func (e *Exporter) ExportRecord(record *typesv1.Record) (*Manifest, error) {
+	acp := e.translator.ByName(oasf.FamilyModule, ModuleName)
+	if acp == nil {
+		return nil, fmt.Errorf("the schema defines no %s module", ModuleName)
+	}
+	for _, module := range record.GetModules() {
+		if module.GetName() == "" {
+			continue
+		}
+		if ref, err := e.translator.Resolve(oasf.FamilyModule, module.GetName(), int(module.GetId())); err != nil || ref != acp {
+			continue
+		}
+		manifest, err := e.Export(module)
+		if err != nil {
+			return nil, err
+		}
+		manifest.Metadata.Ref.Name = cmp.Or(manifest.Metadata.Ref.Name, record.GetName())
+		manifest.Metadata.Ref.Version = cmp.Or(manifest.Metadata.Ref.Version, record.GetVersion())
+		manifest.Metadata.Description = cmp.Or(manifest.Metadata.Description, record.GetDescription())
+		return manifest, nil
+	}
+	return nil, fmt.Errorf("record '%s' has no %s module", record.GetName(), acp.Name)
+}
+
+// FromModuleData converts module data into the specs and deployment of a
+// manifest. It fails for data a manifest cannot hold, such as schemas that are
+// not objects or several env_var_values for a dependency.
+func FromModuleData(data *moduledata.ACPManifestData) (*Manifest, error) {
+	if data.ACP == nil || data.Deployment == nil {
+		return nil, fmt.Errorf("acp and deployment are required")
+	}
+	manifest := &Manifest{}
+	specs := &manifest.Specs
+	var err error
+	for _, field := range []struct {
+		name   string
+		value  any
+		schema *map[string]any
+	}{
+		{"input", data.ACP.Input, &specs.Input},
+		{"output", data.ACP.Output, &specs.Output},
+		{"config", data.ACP.Config, &specs.Config},
+		{"custom_streaming_update", data.ACP.CustomStreamingUpdate, &specs.CustomStreamingUpdate},
+		{"thread_state", data.ACP.ThreadState, &specs.ThreadState},
+	} {
+		if *field.schema, err = schemaObject(field.name, field.value); err != nil {
+			return nil, err
+		}
+	}
+	if capabilities := data.ACP.Capabilities; capabilities != nil {
+		specs.Capabilities = Capabilities{
+			Threads:    capabilities.Threads,
+			Interrupts: capabilities.InterruptSupport,
+			Callbacks:  capabilities.Callbacks,
+		}
+		if streaming := capabilities.Streaming; streaming != nil {
+			specs.Capabilities.Streaming = &StreamingModes{
+				Values: streaming.ResultStreaming,
+				Custom: streaming.CustomObjectsStreaming,
+			}
+		}
+	}
+	for i, interrupt := range data.ACP.Interrupts {
+		converted := Interrupt{InterruptType: interrupt.InterruptType}
+		if converted.InterruptPayload, err = schemaObject(fmt.Sprintf("interrupts[%d].interrupt_payload", i), interrupt.InterruptPayload); err != nil {
+			return nil, err
+		}
+		if converted.ResumePayload, err = schemaObject(fmt.Sprintf("interrupts[%d].resume_payload", i), interrupt.ResumePayload); err != nil {
+			return nil, err
+		}
+		specs.Interrupts = append(specs.Interrupts, converted)
+	}
+
+	deployment := &manifest.Deployment
+	deployment.DeploymentOptions = data.Deployment.DeploymentOptions
+	for _, envVar := range data.Deployment.EnvVars {
+		deployment.EnvVars = append(deployment.EnvVars, EnvVar{
+			Name:         envVar.Name,
+			Desc:         envVar.Description,
+			Required:     envVar.Required,
+			DefaultValue: envVar.DefaultValue,
+		})
+	}
+	for i, agentDep := range data.Deployment.AgentDeps {
+		dependency := Dependency{
+			Name:             agentDep.Name,
+			DeploymentOption: agentDep.DeploymentOption,
+		}
+		if ref := agentDep.Ref; ref != nil {
+			dependency.Ref = AgentRef{Name: ref.Name, Version: ref.Version}
+			if ref.Locator != nil && len(ref.Locator.URLs) > 0 {
+				dependency.Ref.URL = ref.Locator.URLs[0]
+			}
+		}
+		switch len(agentDep.EnvVarValues) {
+		case 0:
+		case 1:
+			values := manifestValues(agentDep.EnvVarValues[0])
+			dependency.EnvVarValues = &values
+		default:
+			return nil, fmt.Errorf("agent_deps[%d]: a manifest dependency holds a single env_var_values", i)
+		}
+		deployment.Dependencies = append(deployment.Dependencies, dependency)
+	}
+	return manifest, nil
+}
+
+// schemaObject returns the OpenAPI schema object of a module data attribute.
+func schemaObject(name string, value any) (map[string]any, error) {
+	if value == nil {
+		return nil, nil
+	}
+	object, ok := value.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a schema object", name)
+	}
+	return object, nil
+}
+
+func manifestValues(values moduledata.EnvVarValues) EnvVarValues {
+	result := EnvVarValues{Name: values.Name}
+	for _, value := range values.Values {
+		if result.Values == nil {
+			result.Values = map[string]string{}
+		}
+		result.Values[value.Name] = value.Value
+	}
+	for _, dependency := range values.EnvDeps {
+		result.Dependencies = append(result.Dependencies, manifestValues(dependency))
+	}
+	return result
+}
+
+// artifactManifest decodes the manifest stored in a module artifact.
+func artifactManifest(artifact *typesv1.Descriptor) (*Manifest, error) {
+	if artifact.GetArtifactType() != ArtifactType {
+		return nil, nil
+	}
+	data := artifact.GetData()
+	if len(data) == 0 && artifact.GetJson() != nil {
+		var err error
+		if data, err = protojson.Marshal(artifact.GetJson()); err != nil {
+			return nil, err
+		}
+	}
+	if len(data) == 0 {
+		return nil, nil
+	}
+	manifest := &Manifest{}
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return nil, fmt.Errorf("invalid ACP manifest artifact: %w", err)
+	}
+	return manifest, nil
+}
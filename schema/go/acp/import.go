@@ -0,0 +1,220 @@
+// Package acp converts ACP agent manifests from and to integration/acp
+// modules.
+//
+// The module data holds the agent specs and deployment of the manifest, with
+// the fields renamed after the schema objects, e.g. capabilities.interrupts
+// becomes interrupt_support and env_vars[].desc becomes description. The
+// manifest metadata has no place in the module data; the original manifest is
+// stored as the module artifact, from which Export restores it. The module
+// name and id are resolved through the schema.
+package acp
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+
+	typesv1 "github.com/agntcy/oasf/proto/go/agntcy/oasf/types/v1"
+	"github.com/agntcy/oasf/schema/go/moduledata"
+	"github.com/agntcy/oasf/schema/go/oasf"
+)
+
+const (
+	// ModuleName is the class name of the ACP module.
+	ModuleName = "acp"
+	// ArtifactType is the artifact type of the manifest module artifact.
+	ArtifactType = "application/vnd.agntcy.acp.manifest+json"
+)
+
+// Types of deployment options.
+const (
+	DeploymentSourceCode    = "source_code"
+	DeploymentDocker        = "docker"
+	DeploymentRemoteService = "remote_service"
+)
+
+// Importer converts manifests into ACP modules of a schema.
+type Importer struct {
+	translator *oasf.Translator
+}
+
+// NewImporter returns an importer resolving the ACP module of the schema.
+func NewImporter(schema *oasf.Schema) *Importer {
+	return &Importer{translator: oasf.NewTranslator(schema)}
+}
+
+// ImportFile imports a manifest file.
+func (i *Importer) ImportFile(path string) (*typesv1.Module, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	module, err := i.Import(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return module, nil
+}
+
+// Import converts a manifest into an integration/acp module.
+func (i *Importer) Import(data []byte) (*typesv1.Module, error) {
+	ref := i.translator.ByName(oasf.FamilyModule, ModuleName)
+	if ref == nil {
+		return nil, fmt.Errorf("the schema defines no %s module", ModuleName)
+	}
+	var manifest Manifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("invalid ACP manifest: %w", err)
+	}
+	if err := checkManifest(&manifest); err != nil {
+		return nil, err
+	}
+
+	sum := sha256.Sum256(data)
+	module := &typesv1.Module{
+		Name: ref.Name,
+		Id:   uint32(ref.ID),
+		Artifact: &typesv1.Descriptor{
+			MediaType:    "application/json",
+			ArtifactType: ArtifactType,
+			Size:         uint64(len(data)),
+			Digest:       "sha256:" + hex.EncodeToString(sum[:]),
+			Data:         slices.Clone(data),
+		},
+	}
+	if err := moduledata.EncodeACPManifestData(module, ModuleData(&manifest)); err != nil {
+		return nil, err
+	}
+	return module, nil
+}
+
+// ModuleData converts the specs and deployment of a manifest into module data.
+func ModuleData(manifest *Manifest) *moduledata.ACPManifestData {
+	specs := manifest.Specs
+	agent := &moduledata.AgentConnectProtocol{
+		Capabilities: &moduledata.ACPCapabilities{
+			Threads:          specs.Capabilities.Threads,
+			InterruptSupport: specs.Capabilities.Interrupts,
+			Callbacks:        specs.Capabilities.Callbacks,
+		},
+		Input:  specs.Input,
+		Output: specs.Output,
+		Config: specs.Config,
+	}
+	if streaming := specs.Capabilities.Streaming; streaming != nil {
+		agent.Capabilities.Streaming = &moduledata.ACPStreamingModes{
+			ResultStreaming:        streaming.Values,
+			CustomObjectsStreaming: streaming.Custom,
+		}
+	}
+	// Optional schemas are only set when present, since a nil map stored in
+	// an any would be encoded as null.
+	if specs.CustomStreamingUpdate != nil {
+		agent.CustomStreamingUpdate = specs.CustomStreamingUpdate
+	}
+	if specs.ThreadState != nil {
+		agent.ThreadState = specs.ThreadState
+	}
+	for _, interrupt := range specs.Interrupts {
+		agent.Interrupts = append(agent.Interrupts, moduledata.ACPInterrupts{
+			InterruptType:    interrupt.InterruptType,
+			InterruptPayload: interrupt.InterruptPayload,
+			ResumePayload:    interrupt.ResumePayload,
+		})
+	}
+
+	deployment := &moduledata.ACPDeployment{
+		DeploymentOptions: manifest.Deployment.DeploymentOptions,
+	}
+	for _, envVar := range manifest.Deployment.EnvVars {
+		deployment.EnvVars = append(deployment.EnvVars, moduledata.EnvVar{
+			Name:         envVar.Name,
+			Description:  envVar.Desc,
+			Required:     envVar.Required,
+			DefaultValue: envVar.DefaultValue,
+		})
+	}
+	for _, dependency := range manifest.Deployment.Dependencies {
+		agentDep := moduledata.ACPAgentDependency{
+			Name:             dependency.Name,
+			Ref:              agentReference(dependency.Ref),
+			DeploymentOption: dependency.DeploymentOption,
+		}
+		if dependency.EnvVarValues != nil {
+			agentDep.EnvVarValues = []moduledata.EnvVarValues{envVarValues(*dependency.EnvVarValues)}
+		}
+		deployment.AgentDeps = append(deployment.AgentDeps, agentDep)
+	}
+	return &moduledata.ACPManifestData{ACP: agent, Deployment: deployment}
+}
+
+// agentReference converts a manifest reference, whose url becomes a url
+// locator.
+func agentReference(ref AgentRef) *moduledata.ACPAgentReference {
+	reference := &moduledata.ACPAgentReference{Name: ref.Name, Version: ref.Version}
+	if ref.URL != "" {
+		reference.Locator = &moduledata.Locator{Type: moduledata.LocatorTypeURL, URLs: []string{ref.URL}}
+	}
+	return reference
+}
+
+// envVarValues converts the values of a dependency, listing them sorted by
+// name.
+func envVarValues(values EnvVarValues) moduledata.EnvVarValues {
+	result := moduledata.EnvVarValues{Name: values.Name, Values: []moduledata.KeyValueObject{}}
+	for _, name := range slices.Sorted(maps.Keys(values.Values)) {
+		result.Values = append(result.Values, moduledata.KeyValueObject{Name: name, Value: values.Values[name]})
+	}
+	for _, dependency := range values.Dependencies {
+		result.EnvDeps = append(result.EnvDeps, envVarValues(dependency))
+	}
+	return result
+}
+
+// checkManifest reports the missing fields of a manifest and its unknown
+// deployment option types.
+func checkManifest(manifest *Manifest) error {
+	var errs []error
+	if manifest.Metadata.Ref.Name == "" {
+		errs = append(errs, errors.New("metadata.ref.name is required"))
+	}
+	if manifest.Metadata.Ref.Version == "" {
+		errs = append(errs, errors.New("metadata.ref.version is required"))
+	}
+	for _, field := range []struct {
+		name   string
+		schema map[string]any
+	}{
+		{"specs.input", manifest.Specs.Input},
+		{"specs.output", manifest.Specs.Output},
+		{"specs.config", manifest.Specs.Config},
+	} {
+		if field.schema == nil {
+			errs = append(errs, fmt.Errorf("%s is required", field.name))
+		}
+	}
+	if len(manifest.Deployment.DeploymentOptions) == 0 {
+		errs = append(errs, errors.New("deployment.deployment_options is required"))
+	}
+	for i, option := range manifest.Deployment.DeploymentOptions {
+		switch option["type"] {
+		case DeploymentSourceCode, DeploymentDocker, DeploymentRemoteService:
+		default:
+			errs = append(errs, fmt.Errorf("deployment.deployment_options[%d]: unknown type %v", i, option["type"]))
+		}
+	}
+	for i, interrupt := range manifest.Specs.Interrupts {
+		if interrupt.InterruptType == "" {
+			errs = append(errs, fmt.Errorf("specs.interrupts[%d]: interrupt_type is required", i))
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("invalid ACP manifest: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,210 @@
+package acp
+
+import (
+	"bytes"
+	"encoding/json"
+	"maps"
+	"slices"
+)
+
+// Manifest is an ACP agent manifest, as published by the Agent Connect
+// Protocol (https://github.com/agntcy/acp-spec).
+//
+// Fields the manifest types do not declare, such as metadata.tags, are kept
+// when decoding a manifest and encoded again with it, at the same path.
+type Manifest struct {
+	Metadata   Metadata   `json:"metadata"`
+	Specs      Specs      `json:"specs"`
+	Deployment Deployment `json:"deployment"`
+
+	unknown []unknownField
+}
+
+// unknownField is a field a manifest type does not declare. Its path holds
+// object keys and array indexes.
+type unknownField struct {
+	path  []any
+	value any
+}
+
+// UnmarshalJSON decodes a manifest and keeps its unknown fields.
+func (m *Manifest) UnmarshalJSON(data []byte) error {
+	type plain Manifest
+	if err := json.Unmarshal(data, (*plain)(m)); err != nil {
+		return err
+	}
+	known, err := json.Marshal((*plain)(m))
+	if err != nil {
+		return err
+	}
+	var original, decoded any
+	if err := decodeJSON(data, &original); err != nil {
+		return err
+	}
+	if err := decodeJSON(known, &decoded); err != nil {
+		return err
+	}
+	m.unknown = unknownFields(nil, original, decoded)
+	return nil
+}
+
+// MarshalJSON encodes a manifest with its unknown fields. An unknown field is
+// only restored where its parent is present and its key or index unset.
+func (m Manifest) MarshalJSON() ([]byte, error) {
+	type plain Manifest
+	data, err := json.Marshal(plain(m))
+	if err != nil || len(m.unknown) == 0 {
+		return data, err
+	}
+	var manifest any
+	if err := decodeJSON(data, &manifest); err != nil {
+		return nil, err
+	}
+	for _, field := range m.unknown {
+		restore(manifest, field.path, field.value)
+	}
+	return json.Marshal(manifest)
+}
+
+// decodeJSON decodes data keeping numbers exact.
+func decodeJSON(data []byte, value *any) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	return decoder.Decode(value)
+}
+
+// unknownFields returns the fields of an original JSON value missing from the
+// value decoded by the manifest types. Arrays are compared by position when
+// both have the same length.
+func unknownFields(path []any, original, known any) []unknownField {
+	var fields []unknownField
+	switch original := original.(type) {
+	case map[string]any:
+		known, _ := known.(map[string]any)
+		for _, key := range slices.Sorted(maps.Keys(original)) {
+			fieldPath := append(slices.Clone(path), key)
+			if value, ok := known[key]; ok {
+				fields = append(fields, unknownFields(fieldPath, original[key], value)...)
+			} else {
+				fields = append(fields, unknownField{path: fieldPath, value: original[key]})
+			}
+		}
+	case []any:
+		if known, ok := known.([]any); ok && len(known) == len(original) {
+			for i := range original {
+				fields = append(fields, unknownFields(append(slices.Clone(path), i), original[i], known[i])...)
+			}
+		}
+	}
+	return fields
+}
+
+// restore sets the unknown field at a path of a decoded JSON value.
+func restore(value any, path []any, field any) {
+	for i, step := range path {
+		last := i == len(path)-1
+		switch step := step.(type) {
+		case string:
+			object, ok := value.(map[string]any)
+			if !ok {
+				return
+			}
+			if last {
+				if _, ok := object[step]; !ok {
+					object[step] = field
+				}
+				return
+			}
+			value = object[step]
+		case int:
+			array, ok := value.([]any)
+			if !ok || step >= len(array) || last {
+				return
+			}
+			value = array[step]
+		}
+	}
+}
+
+// Metadata identifies the agent of a manifest.
+type Metadata struct {
+	Ref         AgentRef `json:"ref"`
+	Description string   `json:"description"`
+}
+
+// AgentRef references an agent manifest by name and version.
+type AgentRef struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	// URL is where the manifest can be fetched from.
+	URL string `json:"url,omitempty"`
+}
+
+// Specs describes the capabilities of an agent and the schemas of its input,
+// output, config and interrupts. Schemas are OpenAPI schema objects.
+type Specs struct {
+	Capabilities          Capabilities   `json:"capabilities"`
+	Input                 map[string]any `json:"input"`
+	Output                map[string]any `json:"output"`
+	CustomStreamingUpdate map[string]any `json:"custom_streaming_update,omitempty"`
+	ThreadState           map[string]any `json:"thread_state,omitempty"`
+	Config                map[string]any `json:"config"`
+	Interrupts            []Interrupt    `json:"interrupts,omitempty"`
+}
+
+// Capabilities declares the invocation features an agent supports.
+type Capabilities struct {
+	Threads    *bool           `json:"threads,omitempty"`
+	Interrupts *bool           `json:"interrupts,omitempty"`
+	Callbacks  *bool           `json:"callbacks,omitempty"`
+	Streaming  *StreamingModes `json:"streaming,omitempty"`
+}
+
+// StreamingModes declares the streaming modes an agent supports.
+type StreamingModes struct {
+	// Values is true if the agent streams run results.
+	Values *bool `json:"values,omitempty"`
+	// Custom is true if the agent streams custom_streaming_update objects.
+	Custom *bool `json:"custom,omitempty"`
+}
+
+// Interrupt describes an interrupt of an agent run and the payload resuming
+// it.
+type Interrupt struct {
+	InterruptType    string         `json:"interrupt_type"`
+	InterruptPayload map[string]any `json:"interrupt_payload"`
+	ResumePayload    map[string]any `json:"resume_payload"`
+}
+
+// Deployment describes how an agent can be deployed or consumed.
+type Deployment struct {
+	// DeploymentOptions are source_code, docker and remote_service options,
+	// kept as decoded JSON objects.
+	DeploymentOptions []map[string]any `json:"deployment_options"`
+	EnvVars           []EnvVar         `json:"env_vars,omitempty"`
+	Dependencies      []Dependency     `json:"dependencies,omitempty"`
+}
+
+// EnvVar is an environment variable of an agent.
+type EnvVar struct {
+	Name         string `json:"name"`
+	Desc         string `json:"desc"`
+	Required     *bool  `json:"required,omitempty"`
+	DefaultValue string `json:"defaultValue,omitempty"`
+}
+
+// Dependency is another agent an agent depends on.
+type Dependency struct {
+	Name             string        `json:"name"`
+	Ref              AgentRef      `json:"ref"`
+	DeploymentOption string        `json:"deployment_option,omitempty"`
+	EnvVarValues     *EnvVarValues `json:"env_var_values,omitempty"`
+}
+
+// EnvVarValues sets the environment variables of an agent and of its
+// dependencies.
+type EnvVarValues struct {
+	Name         string            `json:"name,omitempty"`
+	Values       map[string]string `json:"values,omitempty"`
+	Dependencies []EnvVarValues    `json:"dependencies,omitempty"`
+}
@@ -0,0 +1,255 @@
+// Package agentskills imports Agent Skills packages, directories holding a
+// SKILL.md file and its artifacts, into core/language_model/agentskills
+// modules.
+//
+// The SKILL.md frontmatter is parsed into the skill manifest, every other file
+// of the package is listed as an artifact with its sha256 hash, and the package
+// is validated against the Agent Skills specification
+// (https://agentskills.io/specification/). Validation problems do not fail the
+// import; they are reported in the validation of the module data.
+package agentskills
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	typesv1 "github.com/agntcy/oasf/proto/go/agntcy/oasf/types/v1"
+	"github.com/agntcy/oasf/schema/go/moduledata"
+	"github.com/agntcy/oasf/schema/go/oasf"
+)
+
+const (
+	// ModuleName is the class name of the Agent Skills module.
+	ModuleName = "agentskills"
+	// SkillFile is the name of the skill definition file of a package.
+	SkillFile = "SKILL.md"
+	// ArtifactType is the artifact type of the SKILL.md module artifact.
+	ArtifactType = "application/vnd.agentskills.skill+markdown"
+	// Validator is the validator name reported in the module data.
+	Validator = "github.com/agntcy/oasf/schema/go/agentskills"
+)
+
+// artifactTypes maps the top-level directories of a package to the type of
+// the artifacts they hold.
+var artifactTypes = map[string]string{
+	"scripts":    moduledata.AgentskillsArtifactTypeScript,
+	"references": moduledata.AgentskillsArtifactTypeReference,
+	"assets":     moduledata.AgentskillsArtifactTypeAsset,
+	"templates":  moduledata.AgentskillsArtifactTypeTemplate,
+	"workflows":  moduledata.AgentskillsArtifactTypeWorkflow,
+}
+
+// Option configures an Importer.
+type Option func(*Importer)
+
+// WithSource records where the packages were obtained from, e.g. a
+// source_code locator of their repository and the commit they were read at.
+func WithSource(locator *moduledata.Locator, revision string) Option {
+	return func(i *Importer) {
+		i.sourceLocator = locator
+		i.sourceRevision = revision
+	}
+}
+
+// WithClock sets the function returning the validation time. It defaults to
+// time.Now.
+func WithClock(now func() time.Time) Option {
+	return func(i *Importer) {
+		i.now = now
+	}
+}
+
+// Importer converts Agent Skills packages into modules of a schema.
+type Importer struct {
+	translator     *oasf.Translator
+	sourceLocator  *moduledata.Locator
+	sourceRevision string
+	now            func() time.Time
+}
+
+// NewImporter returns an importer resolving the Agent Skills module of the
+// schema.
+func NewImporter(schema *oasf.Schema, opts ...Option) *Importer {
+	i := &Importer{translator: oasf.NewTranslator(schema), now: time.Now}
+	for _, opt := range opts {
+		opt(i)
+	}
+	return i
+}
+
+// ImportDir imports the Agent Skills package of a directory. It fails only when
+// the schema defines no agentskills module, or the directory cannot be read or
+// has no SKILL.md file.
+func (i *Importer) ImportDir(dir string) (*typesv1.Module, error) {
+	ref := i.translator.ByName(oasf.FamilyModule, ModuleName)
+	if ref == nil {
+		return nil, fmt.Errorf("the schema defines no %s module", ModuleName)
+	}
+	skillMD, err := os.ReadFile(filepath.Join(dir, SkillFile))
+	if err != nil {
+		return nil, fmt.Errorf("not an Agent Skills package: %w", err)
+	}
+	files, err := listFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	// The skill name must match the directory name, which "." does not tell.
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+	v := &validation{}
+	manifest, references := parseSkill(skillMD, filepath.Base(abs), v)
+	artifacts, err := hashArtifacts(dir, files, references)
+	if err != nil {
+		return nil, err
+	}
+	artifactsValid := true
+	for _, reference := range references {
+		switch {
+		case !fs.ValidPath(reference):
+			v.artifactError("SKILL.md references %s outside of the package", reference)
+		case !slices.Contains(files, reference) && !slices.ContainsFunc(files, func(file string) bool { return strings.HasPrefix(file, reference+"/") }):
+			v.artifactError("SKILL.md references the missing artifact %s", reference)
+		default:
+			continue
+		}
+		artifactsValid = false
+	}
+
+	data := &moduledata.AgentskillsData{
+		SkillFile:      SkillFile,
+		SkillManifest:  manifest,
+		SourceLocator:  i.sourceLocator,
+		SourceRevision: i.sourceRevision,
+		Artifacts:      artifacts,
+		Validation: &moduledata.AgentskillsValidation{
+			ValidationStatus:   v.status(),
+			Validator:          Validator,
+			ValidatedAt:        i.now().UTC().Format(time.RFC3339),
+			SkillMDValid:       !v.skillMDInvalid,
+			ArtifactsValid:     &artifactsValid,
+			ValidationErrors:   v.errors,
+			ValidationWarnings: v.warnings,
+		},
+	}
+	sum := sha256.Sum256(skillMD)
+	module := &typesv1.Module{
+		Name: ref.Name,
+		Id:   uint32(ref.ID),
+		Artifact: &typesv1.Descriptor{
+			MediaType:    "text/markdown",
+			ArtifactType: ArtifactType,
+			Size:         uint64(len(skillMD)),
+			Digest:       "sha256:" + hex.EncodeToString(sum[:]),
+			Data:         skillMD,
+		},
+	}
+	if err := moduledata.EncodeAgentskillsData(module, data); err != nil {
+		return nil, err
+	}
+	return module, nil
+}
+
+// listFiles returns the slash-separated paths of the files of a package, except
+// SKILL.md and hidden files.
+func listFiles(dir string) ([]string, error) {
+	var files []string
+	err := fs.WalkDir(os.DirFS(dir), ".", func(name string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if name != "." && strings.HasPrefix(entry.Name(), ".") {
+			if entry.IsDir() {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		if entry.Type().IsRegular() && name != SkillFile {
+			files = append(files, name)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the files of %s: %w", dir, err)
+	}
+	return files, nil
+}
+
+// hashArtifacts describes the files of a package. Files referenced by SKILL.md
+// are marked as required.
+func hashArtifacts(dir string, files, references []string) ([]moduledata.AgentskillsArtifact, error) {
+	var artifacts []moduledata.AgentskillsArtifact
+	for _, file := range files {
+		content, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(file)))
+		if err != nil {
+			return nil, err
+		}
+		sum := sha256.Sum256(content)
+		artifact := moduledata.AgentskillsArtifact{
+			Path:         file,
+			Type:         moduledata.AgentskillsArtifactTypeOther,
+			ArtifactHash: "sha256:" + hex.EncodeToString(sum[:]),
+		}
+		if top, _, nested := strings.Cut(file, "/"); nested && artifactTypes[top] != "" {
+			artifact.Type = artifactTypes[top]
+		}
+		if slices.ContainsFunc(references, func(reference string) bool {
+			return reference == file || strings.HasPrefix(file, reference+"/")
+		}) {
+			required := true
+			artifact.Required = &required
+		}
+		artifacts = append(artifacts, artifact)
+	}
+	return artifacts, nil
+}
+
+// validation collects the problems found in a package.
+type validation struct {
+	errors         []string
+	warnings       []string
+	skillMDInvalid bool
+}
+
+func (v *validation) skillError(format string, args ...any) {
+	v.errors = append(v.errors, fmt.Sprintf(format, args...))
+	v.skillMDInvalid = true
+}
+
+func (v *validation) artifactError(format string, args ...any) {
+	v.errors = append(v.errors, fmt.Sprintf(format, args...))
+}
+
+func (v *validation) warn(format string, args ...any) {
+	v.warnings = append(v.warnings, fmt.Sprintf(format, args...))
+}
+
+func (v *validation) status() string {
+	switch {
+	case len(v.errors) > 0:
+		return moduledata.AgentskillsValidationValidationStatusFailed
+	case len(v.warnings) > 0:
+		return moduledata.AgentskillsValidationValidationStatusWarning
+	}
+	return moduledata.AgentskillsValidationValidationStatusPassed
+}
+
+// cleanReference returns the package path a SKILL.md link target refers to,
+// or false for urls and anchors.
+func cleanReference(target string) (string, bool) {
+	target, _, _ = strings.Cut(target, "#")
+	if target == "" || strings.Contains(target, "://") || strings.HasPrefix(target, "mailto:") || strings.HasPrefix(target, "/") {
+		return "", false
+	}
+	return path.Clean(target), true
+}
@@ -0,0 +1,213 @@
+package agentskills_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/agntcy/oasf/proto/go/descriptor"
+	"github.com/agntcy/oasf/schema/go/agentskills"
+	"github.com/agntcy/oasf/schema/go/moduledata"
+	"github.com/agntcy/oasf/schema/go/oasf"
+	"github.com/agntcy/oasf/schema/go/validator"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const schemaDir = "../.."
+
+var (
+	skillDir    = filepath.Join("testdata", "pdf-processing")
+	validatedAt = time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	schema      *oasf.Schema
+)
+
+var _ = BeforeEach(func() {
+	var err error
+	schema, err = oasf.Load(schemaDir)
+	Expect(err).NotTo(HaveOccurred())
+})
+
+func importDir(dir string, opts ...agentskills.Option) *moduledata.AgentskillsData {
+	opts = append(opts, agentskills.WithClock(func() time.Time { return validatedAt }))
+	module, err := agentskills.NewImporter(schema, opts...).ImportDir(dir)
+	Expect(err).NotTo(HaveOccurred())
+	data, err := moduledata.DecodeAgentskillsData(module)
+	Expect(err).NotTo(HaveOccurred())
+	return data
+}
+
+// copySkill copies the test package into a temporary directory and replaces
+// its SKILL.md when skillMD is not empty.
+func copySkill(skillMD string) string {
+	dir := filepath.Join(GinkgoT().TempDir(), "pdf-processing")
+	Expect(os.CopyFS(dir, os.DirFS(skillDir))).To(Succeed())
+	if skillMD != "" {
+		Expect(os.WriteFile(filepath.Join(dir, "SKILL.md"), []byte(skillMD), 0o600)).To(Succeed())
+	}
+	return dir
+}
+
+func fileHash(name string) string {
+	content, err := os.ReadFile(filepath.Join(skillDir, name))
+	Expect(err).NotTo(HaveOccurred())
+	sum := sha256.Sum256(content)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+var _ = Describe("Agent Skills import", func() {
+	It("should parse the SKILL.md frontmatter", func() {
+		data := importDir(skillDir)
+		Expect(data.SkillFile).To(Equal("SKILL.md"))
+		Expect(data.SkillManifest).To(Equal(&moduledata.AgentskillsManifest{
+			Name:          "pdf-processing",
+			Description:   "Extract text and tables from PDF files, fill forms and merge documents. Use when working with PDF files.",
+			Version:       "1.0",
+			License:       "Apache-2.0",
+			Compatibility: []string{"Requires Python 3.10+ and network access"},
+			AllowedTools:  []string{"Bash(python:*)", "Read"},
+			FrontmatterMetadata: map[string]any{
+				"metadata": map[string]any{"author": "agntcy", "version": "1.0"},
+			},
+		}))
+	})
+
+	It("should hash every artifact of the package", func() {
+		required := true
+		Expect(importDir(skillDir).Artifacts).To(Equal([]moduledata.AgentskillsArtifact{
+			{Path: "assets/form.txt", Type: "asset", ArtifactHash: fileHash("assets/form.txt")},
+			{Path: "references/REFERENCE.md", Type: "reference", ArtifactHash: fileHash("references/REFERENCE.md"), Required: &required},
+			{Path: "scripts/extract.py", Type: "script", ArtifactHash: fileHash("scripts/extract.py"), Required: &required},
+		}))
+
+		dir := copySkill("")
+		Expect(os.MkdirAll(filepath.Join(dir, ".git"), 0o700)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(dir, ".git", "HEAD"), []byte("ref: refs/heads/main\n"), 0o600)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(dir, ".env"), []byte("TOKEN=secret\n"), 0o600)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(dir, "notes.txt"), []byte("notes\n"), 0o600)).To(Succeed())
+		artifacts := importDir(dir).Artifacts
+		Expect(artifacts).To(HaveLen(4), "hidden files are not artifacts")
+		Expect(artifacts[1].Path).To(Equal("notes.txt"))
+		Expect(artifacts[1].Type).To(Equal("other"))
+	})
+
+	It("should report a valid package", func() {
+		artifactsValid := true
+		Expect(importDir(skillDir).Validation).To(Equal(&moduledata.AgentskillsValidation{
+			ValidationStatus: "passed",
+			Validator:        agentskills.Validator,
+			ValidatedAt:      "2025-06-01T12:00:00Z",
+			SkillMDValid:     true,
+			ArtifactsValid:   &artifactsValid,
+		}))
+	})
+
+	It("should take the package name of relative directories", func() {
+		GinkgoT().Chdir(copySkill(""))
+		Expect(importDir(".").Validation.SkillMDValid).To(BeTrue())
+		Expect(importDir("../pdf-processing/").Validation.ValidationStatus).To(Equal("passed"))
+	})
+
+	It("should store SKILL.md as the module artifact", func() {
+		module, err := agentskills.NewImporter(schema).ImportDir(skillDir)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(module.GetName()).To(Equal("core/language_model/agentskills"))
+		Expect(module.GetId()).To(BeEquivalentTo(10302))
+
+		skillMD, err := os.ReadFile(filepath.Join(skillDir, "SKILL.md"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(module.GetArtifact().GetMediaType()).To(Equal("text/markdown"))
+		Expect(module.GetArtifact().GetData()).To(Equal(skillMD))
+		Expect(descriptor.NewVerifier().Verify(context.Background(), module.GetArtifact())).To(Succeed())
+	})
+
+	It("should record the source of the package", func() {
+		locator := &moduledata.Locator{Type: "source_code", URLs: []string{"https://github.com/agntcy/skills"}}
+		data := importDir(skillDir, agentskills.WithSource(locator, "4f2a9c1"))
+		Expect(data.SourceLocator).To(Equal(locator))
+		Expect(data.SourceRevision).To(Equal("4f2a9c1"))
+	})
+
+	It("should produce module data valid against the schema", func() {
+		module, err := agentskills.NewImporter(schema).ImportDir(skillDir)
+		Expect(err).NotTo(HaveOccurred())
+		encoded, err := protojson.Marshal(module.GetData())
+		Expect(err).NotTo(HaveOccurred())
+		var data map[string]any
+		Expect(json.Unmarshal(encoded, &data)).To(Succeed())
+
+		result := validator.New(schema).ValidateObject("agentskills_data", data)
+		Expect(result.Errors).To(BeEmpty())
+		Expect(result.Warnings).To(BeEmpty())
+	})
+
+	It("should report invalid SKILL.md files", func() {
+		data := importDir(copySkill("---\nname: PDF_Processing\ntags: [pdf]\nmetadata:\n  pages: 10\n---\n\n# PDF\n"))
+		Expect(data.SkillManifest.Name).To(Equal("PDF_Processing"))
+		Expect(data.SkillManifest.FrontmatterMetadata).To(Equal(map[string]any{
+			"metadata": map[string]any{"pages": float64(10)},
+			"tags":     []any{"pdf"},
+		}))
+		Expect(data.Validation.ValidationStatus).To(Equal("failed"))
+		Expect(data.Validation.SkillMDValid).To(BeFalse())
+		Expect(*data.Validation.ArtifactsValid).To(BeTrue())
+		Expect(data.Validation.ValidationErrors).To(Equal([]string{
+			`frontmatter field name "PDF_Processing" must consist of lowercase letters, digits and single hyphens`,
+			"frontmatter field description is required",
+		}))
+		Expect(data.Validation.ValidationWarnings).To(Equal([]string{
+			"frontmatter metadata pages should be a string",
+			"unexpected frontmatter field tags",
+		}))
+
+		data = importDir(copySkill("---\nname: pdf\ndescription: PDF tools.\n---\n\n# PDF\n"))
+		Expect(data.Validation.ValidationErrors).To(Equal([]string{
+			`frontmatter field name "pdf" does not match the directory name "pdf-processing"`,
+		}))
+
+		data = importDir(copySkill("# PDF\n"))
+		Expect(data.SkillManifest).To(BeNil())
+		Expect(data.Validation.ValidationErrors).To(Equal([]string{"SKILL.md has no YAML frontmatter"}))
+
+		data = importDir(copySkill("---\nname: [pdf\n---\n"))
+		Expect(data.Validation.ValidationErrors).To(ConsistOf(ContainSubstring("SKILL.md frontmatter is not valid YAML")))
+	})
+
+	It("should warn about deviations from the recommendations", func() {
+		data := importDir(copySkill("---\nname: pdf-processing\ndescription: PDF tools.\nallowed-tools: [Read, Write]\n---\n"))
+		Expect(data.SkillManifest.AllowedTools).To(Equal([]string{"Read", "Write"}))
+		Expect(data.Validation.ValidationStatus).To(Equal("warning"))
+		Expect(data.Validation.SkillMDValid).To(BeTrue())
+		Expect(data.Validation.ValidationWarnings).To(Equal([]string{
+			"frontmatter field allowed-tools should be a space-delimited string",
+			"SKILL.md has no instructions after the frontmatter",
+		}))
+	})
+
+	It("should report missing artifacts", func() {
+		data := importDir(copySkill("---\nname: pdf-processing\ndescription: PDF tools.\n---\n\n" +
+			"Run [merge](./scripts/merge.py), see [forms](references/REFERENCE.md#forms) and [docs](../docs/pdf.md).\n"))
+		Expect(data.Validation.ValidationStatus).To(Equal("failed"))
+		Expect(data.Validation.SkillMDValid).To(BeTrue())
+		Expect(*data.Validation.ArtifactsValid).To(BeFalse())
+		Expect(data.Validation.ValidationErrors).To(Equal([]string{
+			"SKILL.md references the missing artifact scripts/merge.py",
+			"SKILL.md references ../docs/pdf.md outside of the package",
+		}))
+	})
+
+	It("should fail for directories without SKILL.md", func() {
+		_, err := agentskills.NewImporter(schema).ImportDir(filepath.Join(skillDir, "scripts"))
+		Expect(err).To(MatchError(ContainSubstring("not an Agent Skills package")))
+	})
+
+	It("should fail for schemas without an agentskills module", func() {
+		_, err := agentskills.NewImporter(&oasf.Schema{}).ImportDir(skillDir)
+		Expect(err).To(MatchError("the schema defines no agentskills module"))
+	})
+})
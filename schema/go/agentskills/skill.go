@@ -0,0 +1,170 @@
+package agentskills
+
+import (
+	"bytes"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/agntcy/oasf/schema/go/moduledata"
+	"go.yaml.in/yaml/v3"
+)
+
+// Limits of the SKILL.md frontmatter fields set by the specification.
+const (
+	maxNameLength          = 64
+	maxDescriptionLength   = 1024
+	maxCompatibilityLength = 500
+	// maxBodyLines is the recommended maximum length of the SKILL.md body.
+	maxBodyLines = 500
+)
+
+var (
+	namePattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+	// linkPattern matches the targets of markdown links and images.
+	linkPattern = regexp.MustCompile(`\]\(\s*<?([^)\s>]+)>?(?:\s+"[^"]*")?\s*\)`)
+)
+
+// knownFields are the frontmatter fields defined by the specification.
+var knownFields = []string{"name", "description", "license", "compatibility", "metadata", "allowed-tools"}
+
+// parseSkill parses the frontmatter of SKILL.md into a manifest and returns
+// the package paths the body links to. Problems are added to v.
+func parseSkill(content []byte, dirName string, v *validation) (*moduledata.AgentskillsManifest, []string) {
+	frontmatter, body, ok := splitFrontmatter(content)
+	if !ok {
+		v.skillError("SKILL.md has no YAML frontmatter")
+		return nil, references(content)
+	}
+	var fields map[string]any
+	if err := yaml.Unmarshal(frontmatter, &fields); err != nil {
+		v.skillError("SKILL.md frontmatter is not valid YAML: %s", err)
+		return nil, references(body)
+	}
+
+	manifest := &moduledata.AgentskillsManifest{}
+	manifest.Name = stringField(fields, "name", v)
+	switch {
+	case manifest.Name == "":
+		v.skillError("frontmatter field name is required")
+	case len(manifest.Name) > maxNameLength:
+		v.skillError("frontmatter field name is longer than %d characters", maxNameLength)
+	case !namePattern.MatchString(manifest.Name):
+		v.skillError("frontmatter field name %q must consist of lowercase letters, digits and single hyphens", manifest.Name)
+	case manifest.Name != dirName:
+		v.skillError("frontmatter field name %q does not match the directory name %q", manifest.Name, dirName)
+	}
+	manifest.Description = stringField(fields, "description", v)
+	switch {
+	case strings.TrimSpace(manifest.Description) == "":
+		v.skillError("frontmatter field description is required")
+	case len(manifest.Description) > maxDescriptionLength:
+		v.skillError("frontmatter field description is longer than %d characters", maxDescriptionLength)
+	}
+	manifest.License = stringField(fields, "license", v)
+	if compatibility := stringField(fields, "compatibility", v); compatibility != "" {
+		if len(compatibility) > maxCompatibilityLength {
+			v.skillError("frontmatter field compatibility is longer than %d characters", maxCompatibilityLength)
+		}
+		manifest.Compatibility = []string{compatibility}
+	}
+	manifest.AllowedTools = allowedTools(fields["allowed-tools"], v)
+
+	metadata := map[string]any{}
+	if value, ok := fields["metadata"]; ok {
+		entries, ok := value.(map[string]any)
+		if !ok {
+			v.skillError("frontmatter field metadata must be a mapping")
+		}
+		for _, key := range slices.Sorted(maps.Keys(entries)) {
+			if _, ok := entries[key].(string); !ok {
+				v.warn("frontmatter metadata %s should be a string", key)
+			}
+		}
+		if version, ok := entries["version"].(string); ok {
+			manifest.Version = version
+		}
+		if len(entries) > 0 {
+			metadata["metadata"] = entries
+		}
+	}
+	for _, key := range slices.Sorted(maps.Keys(fields)) {
+		if !slices.Contains(knownFields, key) {
+			v.warn("unexpected frontmatter field %s", key)
+			metadata[key] = fields[key]
+		}
+	}
+	if len(metadata) > 0 {
+		manifest.FrontmatterMetadata = metadata
+	}
+
+	if len(bytes.TrimSpace(body)) == 0 {
+		v.warn("SKILL.md has no instructions after the frontmatter")
+	} else if lines := bytes.Count(body, []byte("\n")); lines > maxBodyLines {
+		v.warn("SKILL.md body has %d lines, more than the recommended %d", lines, maxBodyLines)
+	}
+	return manifest, references(body)
+}
+
+// splitFrontmatter splits SKILL.md into its frontmatter, delimited by ---
+// lines, and its body.
+func splitFrontmatter(content []byte) ([]byte, []byte, bool) {
+	content = bytes.ReplaceAll(content, []byte("\r\n"), []byte("\n"))
+	rest, ok := bytes.CutPrefix(content, []byte("---\n"))
+	if !ok {
+		return nil, content, false
+	}
+	if frontmatter, body, ok := bytes.Cut(rest, []byte("\n---\n")); ok {
+		return frontmatter, body, true
+	}
+	if frontmatter, ok := bytes.CutSuffix(rest, []byte("\n---")); ok {
+		return frontmatter, nil, true
+	}
+	return nil, content, false
+}
+
+func stringField(fields map[string]any, key string, v *validation) string {
+	value, ok := fields[key]
+	if !ok || value == nil {
+		return ""
+	}
+	s, ok := value.(string)
+	if !ok {
+		v.skillError("frontmatter field %s must be a string", key)
+		return fmt.Sprint(value)
+	}
+	return s
+}
+
+// allowedTools reads allowed-tools, a space-delimited list of tools. A YAML
+// list is accepted with a warning.
+func allowedTools(value any, v *validation) []string {
+	switch value := value.(type) {
+	case nil:
+		return nil
+	case string:
+		return strings.Fields(value)
+	case []any:
+		v.warn("frontmatter field allowed-tools should be a space-delimited string")
+		var tools []string
+		for _, tool := range value {
+			tools = append(tools, fmt.Sprint(tool))
+		}
+		return tools
+	}
+	v.skillError("frontmatter field allowed-tools must be a space-delimited string")
+	return nil
+}
+
+// references returns the distinct package paths markdown links point to.
+func references(body []byte) []string {
+	var result []string
+	for _, match := range linkPattern.FindAllSubmatch(body, -1) {
+		if reference, ok := cleanReference(string(match[1])); ok && !slices.Contains(result, reference) {
+			result = append(result, reference)
+		}
+	}
+	return result
+}
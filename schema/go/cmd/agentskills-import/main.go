@@ -0,0 +1,64 @@
+// Command agentskills-import imports an Agent Skills package directory into a
+// core/language_model/agentskills module and prints it as JSON. It exits with
+// status 1 when the package fails validation.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/agntcy/oasf/schema/go/agentskills"
+	"github.com/agntcy/oasf/schema/go/moduledata"
+	"github.com/agntcy/oasf/schema/go/oasf"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func main() {
+	schemaDir := flag.String("schema", "schema", "path to the OASF schema tree")
+	sourceURL := flag.String("source-url", "", "url of the repository or registry the package comes from")
+	sourceType := flag.String("source-type", moduledata.LocatorTypeSourceCode, "locator type of the source url")
+	revision := flag.String("revision", "", "revision of the source, e.g. a commit")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: %s [flags] <skill directory>\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	schema, err := oasf.Load(*schemaDir)
+	if err != nil {
+		log.Fatal(err)
+	}
+	var opts []agentskills.Option
+	if *sourceURL != "" {
+		opts = append(opts, agentskills.WithSource(&moduledata.Locator{Type: *sourceType, URLs: []string{*sourceURL}}, *revision))
+	}
+	module, err := agentskills.NewImporter(schema, opts...).ImportDir(flag.Arg(0))
+	if err != nil {
+		log.Fatal(err)
+	}
+	out, err := protojson.MarshalOptions{Multiline: true, UseProtoNames: true}.Marshal(module)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(string(out))
+
+	data, err := moduledata.DecodeAgentskillsData(module)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, warning := range data.Validation.ValidationWarnings {
+		log.Printf("warning: %s", warning)
+	}
+	for _, validationError := range data.Validation.ValidationErrors {
+		log.Printf("error: %s", validationError)
+	}
+	if data.Validation.ValidationStatus == moduledata.AgentskillsValidationValidationStatusFailed {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,31 @@
+// Command moduledata-gen generates the typed module data structs of the
+// moduledata package from an OASF schema tree.
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+
+	"github.com/agntcy/oasf/schema/go/internal/codegen"
+	"github.com/agntcy/oasf/schema/go/oasf"
+)
+
+func main() {
+	schemaDir := flag.String("schema", "../..", "path to the OASF schema tree")
+	out := flag.String("out", "moduledata.gen.go", "output file")
+	pkg := flag.String("package", "moduledata", "package name of the generated file")
+	flag.Parse()
+
+	schema, err := oasf.Load(*schemaDir)
+	if err != nil {
+		log.Fatal(err)
+	}
+	source, err := codegen.ModuleData(schema, *pkg)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*out, source, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
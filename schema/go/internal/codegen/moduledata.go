@@ -0,0 +1,284 @@
+// Package codegen generates Go code from the OASF schema.
+package codegen
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"slices"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/agntcy/oasf/schema/go/oasf"
+)
+
+// ModuleData generates typed Go structs for the data objects of every module
+// class, for the objects they use, and for the module registry of the
+// moduledata package.
+func ModuleData(schema *oasf.Schema, pkg string) ([]byte, error) {
+	g := &generator{
+		schema:   schema,
+		objects:  make(map[string]*oasf.ResolvedEntity),
+		extended: make(map[string]bool),
+	}
+	for _, object := range schema.Objects {
+		g.extended[object.Extends] = true
+	}
+
+	translator := oasf.NewTranslator(schema)
+	var modules []module
+	for _, name := range sortedKeys(schema.Modules) {
+		class, err := schema.ResolveClass(oasf.FamilyModule, name)
+		if err != nil {
+			return nil, err
+		}
+		data := class.Attributes["data"]
+		if class.Category || data == nil || data.Type != "object_t" || data.ObjectType == "module_data" {
+			continue
+		}
+		if err := g.collect(data.ObjectType); err != nil {
+			return nil, err
+		}
+		ref := translator.ByName(oasf.FamilyModule, name)
+		modules = append(modules, module{name: ref.Name, id: ref.ID, object: data.ObjectType})
+	}
+	sort.Slice(modules, func(i, j int) bool { return modules[i].name < modules[j].name })
+
+	g.printf("// Code generated by moduledata-gen. DO NOT EDIT.\n\n")
+	g.printf("package %s\n\n", pkg)
+	g.printf("import typesv1 \"github.com/agntcy/oasf/proto/go/agntcy/oasf/types/v1\"\n\n")
+	g.printf("// SchemaVersion is the version of the schema the types were generated from.\n")
+	g.printf("const SchemaVersion = %q\n\n", schema.Version)
+
+	g.printf("var modules = map[string]func() any{\n")
+	for _, m := range modules {
+		g.printf("%q: func() any { return new(%s) },\n", m.name, goName(m.object))
+	}
+	g.printf("}\n\n")
+
+	for _, m := range modules {
+		typeName := goName(m.object)
+		g.printf("// Decode%s decodes the data of a module %s (id %d).\n", typeName, m.name, m.id)
+		g.printf("func Decode%s(module *typesv1.Module) (*%s, error) {\n", typeName, typeName)
+		g.printf("data := new(%s)\n", typeName)
+		g.printf("if err := Decode(module, data); err != nil {\nreturn nil, err\n}\n")
+		g.printf("return data, nil\n}\n\n")
+		g.printf("// Encode%s stores data as the data of a module %s.\n", typeName, m.name)
+		g.printf("func Encode%s(module *typesv1.Module, data *%s) error {\n", typeName, typeName)
+		g.printf("return Encode(module, data)\n}\n\n")
+	}
+
+	for _, name := range sortedKeys(g.objects) {
+		if err := g.object(g.objects[name]); err != nil {
+			return nil, err
+		}
+	}
+
+	source, err := format.Source(g.buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to format generated code: %w", err)
+	}
+	return source, nil
+}
+
+type module struct {
+	name   string
+	id     int
+	object string
+}
+
+type generator struct {
+	schema  *oasf.Schema
+	objects map[string]*oasf.ResolvedEntity
+	// extended holds the objects other objects extend. Their values may carry
+	// the attributes of any descendant, so they are kept untyped.
+	extended map[string]bool
+	buf      bytes.Buffer
+}
+
+func (g *generator) printf(format string, args ...any) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+// collect resolves the named object and every object its attributes use.
+func (g *generator) collect(name string) error {
+	if g.objects[name] != nil || g.extended[name] {
+		return nil
+	}
+	object, err := g.schema.ResolveObject(name)
+	if err != nil {
+		return err
+	}
+	g.objects[name] = object
+	for _, key := range sortedKeys(object.Attributes) {
+		if attribute := object.Attributes[key]; attribute.Type == "object_t" {
+			if err := g.collect(attribute.ObjectType); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (g *generator) object(object *oasf.ResolvedEntity) error {
+	typeName := goName(object.Name)
+	g.comment("", typeName+" is the "+object.Caption+" object. "+object.Description)
+	g.printf("type %s struct {\n", typeName)
+	for _, key := range sortedKeys(object.Attributes) {
+		attribute := object.Attributes[key]
+		fieldType, err := g.fieldType(attribute)
+		if err != nil {
+			return fmt.Errorf("object '%s': %w", object.Name, err)
+		}
+		tag := attribute.Name
+		if attribute.Requirement != "required" || alternative(object, key) {
+			tag += ",omitempty"
+		}
+		g.comment("\t", attribute.Description)
+		g.printf("%s %s `json:\"%s\"`\n", goName(attribute.Name), fieldType, tag)
+	}
+	g.printf("}\n\n")
+
+	for _, key := range sortedKeys(object.Attributes) {
+		attribute := object.Attributes[key]
+		if len(attribute.Enum) == 0 || g.primitive(attribute.Type) != "string_t" {
+			continue
+		}
+		g.printf("// Values of %s.%s.\n", typeName, goName(attribute.Name))
+		g.printf("const (\n")
+		for _, value := range sortedKeys(attribute.Enum) {
+			g.printf("%s%s%s = %q\n", typeName, goName(attribute.Name), goName(value), value)
+		}
+		g.printf(")\n\n")
+	}
+	return nil
+}
+
+// alternative reports whether the attribute is one of the alternatives of a
+// just_one constraint, which are only required when the others are missing.
+func alternative(object *oasf.ResolvedEntity, key string) bool {
+	return object.Constraints != nil && slices.Contains(object.Constraints.JustOne, key)
+}
+
+func (g *generator) fieldType(attribute *oasf.ResolvedAttribute) (string, error) {
+	var elem string
+	var pointer bool
+	switch attribute.Type {
+	case "object_t":
+		if g.extended[attribute.ObjectType] {
+			elem = "map[string]any"
+		} else {
+			elem = goName(attribute.ObjectType)
+			pointer = true
+		}
+	case "class_t":
+		elem = "map[string]any"
+	case "typed_map_t":
+		value, err := g.scalar(attribute.ValueType)
+		if err != nil {
+			return "", err
+		}
+		elem = "map[string]" + value
+	default:
+		scalar, err := g.scalar(attribute.Type)
+		if err != nil {
+			return "", err
+		}
+		elem = scalar
+		// Empty strings and untyped values are indistinguishable from
+		// missing ones, so only optional numbers and booleans need a pointer.
+		pointer = attribute.Requirement != "required" && scalar != "string" && scalar != "any"
+	}
+	switch {
+	case attribute.IsArray:
+		return "[]" + elem, nil
+	case pointer:
+		return "*" + elem, nil
+	}
+	return elem, nil
+}
+
+func (g *generator) scalar(typeName string) (string, error) {
+	switch g.primitive(typeName) {
+	case "string_t", "bytestring_t":
+		return "string", nil
+	case "integer_t":
+		return "int32", nil
+	case "long_t":
+		return "int64", nil
+	case "float_t":
+		return "float64", nil
+	case "boolean_t":
+		return "bool", nil
+	case "json_t":
+		return "any", nil
+	}
+	return "", fmt.Errorf("type '%s' has no Go equivalent", typeName)
+}
+
+// primitive follows the super types of a dictionary type to its root.
+func (g *generator) primitive(typeName string) string {
+	seen := make(map[string]bool)
+	for !seen[typeName] {
+		seen[typeName] = true
+		dataType := g.schema.Dictionary.Types.Attributes[typeName]
+		if dataType == nil || dataType.Type == "" {
+			return typeName
+		}
+		typeName = dataType.Type
+	}
+	return typeName
+}
+
+func (g *generator) comment(indent, text string) {
+	const width = 80
+	line := indent + "//"
+	for _, word := range strings.Fields(text) {
+		if len(line)+1+len(word) > width && line != indent+"//" {
+			g.printf("%s\n", line)
+			line = indent + "//"
+		}
+		line += " " + word
+	}
+	g.printf("%s\n", line)
+}
+
+// initialisms are name segments spelled in upper case, following Go conventions.
+var initialisms = map[string]string{
+	"a2a": "A2A", "acp": "ACP", "api": "API", "cid": "CID", "cpu": "CPU",
+	"gpu": "GPU", "http": "HTTP", "https": "HTTPS", "id": "ID", "io": "IO",
+	"ip": "IP", "json": "JSON", "llm": "LLM", "mcp": "MCP", "md": "MD",
+	"mime": "MIME", "oci": "OCI", "sdk": "SDK", "sse": "SSE", "tls": "TLS",
+	"ui": "UI", "uri": "URI", "url": "URL", "urls": "URLs", "uris": "URIs",
+}
+
+// goName converts a snake_case or kebab-case schema name into an exported Go
+// identifier, e.g. mcp_server_url becomes MCPServerURL.
+func goName(name string) string {
+	var b strings.Builder
+	for _, word := range strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		if initialism, ok := initialisms[strings.ToLower(word)]; ok {
+			b.WriteString(initialism)
+			continue
+		}
+		runes := []rune(word)
+		b.WriteRune(unicode.ToUpper(runes[0]))
+		b.WriteString(string(runes[1:]))
+	}
+	if b.Len() == 0 || unicode.IsDigit([]rune(b.String())[0]) {
+		return "X" + b.String()
+	}
+	return b.String()
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
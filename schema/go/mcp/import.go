@@ -0,0 +1,356 @@
+// Package mcp imports MCP server.json documents into integration/mcp modules.
+//
+// The module data describes the server connections, one per package and
+// remote, and its tools, prompts and resources. The original server.json is
+// stored as the module artifact rather than in the deprecated mcp_data
+// attribute. The module name and id are resolved through the schema.
+package mcp
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	typesv1 "github.com/agntcy/oasf/proto/go/agntcy/oasf/types/v1"
+	"github.com/agntcy/oasf/schema/go/moduledata"
+	"github.com/agntcy/oasf/schema/go/oasf"
+)
+
+const (
+	// ModuleName is the class name of the MCP module.
+	ModuleName = "mcp"
+	// ArtifactType is the artifact type of the server.json module artifact.
+	ArtifactType = "application/vnd.modelcontextprotocol.server+json"
+	// PublisherProvidedMeta is the _meta key of publisher-provided server
+	// metadata, read for Capabilities.
+	PublisherProvidedMeta = "io.modelcontextprotocol.registry/publisher-provided"
+)
+
+// runtime is the command running the packages of a registry type.
+type runtime struct {
+	command   string
+	args      []string
+	separator string
+}
+
+var runtimes = map[string]runtime{
+	"npm":   {command: "npx", args: []string{"-y"}, separator: "@"},
+	"pypi":  {command: "uvx", separator: "=="},
+	"oci":   {command: "docker", args: []string{"run", "-i", "--rm"}, separator: ":"},
+	"nuget": {command: "dnx", separator: "@"},
+}
+
+// DefaultAudience is the audience of resources that declare none: MCP
+// resources without an audience are meant for both the user and the assistant.
+var DefaultAudience = []string{moduledata.MCPServerResourceAudienceUser, moduledata.MCPServerResourceAudienceAssistant}
+
+// Guess is a module data value the importer could not take from the server.
+type Guess struct {
+	// Field is the JSON path of the module data value, e.g. resources[0].audience.
+	Field  string
+	Value  string
+	Reason string
+}
+
+func (g Guess) String() string {
+	return fmt.Sprintf("%s = %q: %s", g.Field, g.Value, g.Reason)
+}
+
+// Report lists the module data values the importer had to guess.
+type Report struct {
+	Guesses []Guess
+}
+
+func (r *Report) guess(field, value, format string, args ...any) {
+	r.Guesses = append(r.Guesses, Guess{Field: field, Value: value, Reason: fmt.Sprintf(format, args...)})
+}
+
+// Option configures an Importer.
+type Option func(*Importer)
+
+// WithCapabilities sets the tools, prompts and resources of the server,
+// replacing the ones found in its publisher-provided _meta.
+func WithCapabilities(capabilities Capabilities) Option {
+	return func(i *Importer) {
+		i.capabilities = &capabilities
+	}
+}
+
+// Importer converts server.json documents into MCP modules.
+type Importer struct {
+	translator   *oasf.Translator
+	capabilities *Capabilities
+}
+
+// NewImporter returns an importer resolving the MCP module of the schema.
+func NewImporter(schema *oasf.Schema, opts ...Option) *Importer {
+	i := &Importer{translator: oasf.NewTranslator(schema)}
+	for _, opt := range opts {
+		opt(i)
+	}
+	return i
+}
+
+// ImportFile imports a server.json file.
+func (i *Importer) ImportFile(path string) (*typesv1.Module, *Report, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	module, report, err := i.Import(data)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return module, report, nil
+}
+
+// Import converts a server.json document into an MCP module.
+func (i *Importer) Import(data []byte) (*typesv1.Module, *Report, error) {
+	ref := i.translator.ByName(oasf.FamilyModule, ModuleName)
+	if ref == nil {
+		return nil, nil, fmt.Errorf("the schema defines no %s module", ModuleName)
+	}
+	var server Server
+	if err := json.Unmarshal(data, &server); err != nil {
+		return nil, nil, fmt.Errorf("invalid server.json: %w", err)
+	}
+	if server.Name == "" {
+		return nil, nil, fmt.Errorf("invalid server.json: name is required")
+	}
+	capabilities := i.capabilities
+	if capabilities == nil {
+		var err error
+		if capabilities, err = publisherCapabilities(server.Meta); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	report := &Report{}
+	mcpData, err := convert(&server, capabilities, report)
+	if err != nil {
+		return nil, nil, err
+	}
+	sum := sha256.Sum256(data)
+	module := &typesv1.Module{
+		Name: ref.Name,
+		Id:   uint32(ref.ID),
+		Artifact: &typesv1.Descriptor{
+			MediaType:    "application/json",
+			ArtifactType: ArtifactType,
+			Size:         uint64(len(data)),
+			Digest:       "sha256:" + hex.EncodeToString(sum[:]),
+			Data:         slices.Clone(data),
+		},
+	}
+	if err := moduledata.EncodeMCPData(module, mcpData); err != nil {
+		return nil, nil, err
+	}
+	return module, report, nil
+}
+
+func convert(server *Server, capabilities *Capabilities, report *Report) (*moduledata.MCPData, error) {
+	data := &moduledata.MCPData{
+		Name:        server.Name,
+		Description: server.Description,
+	}
+	for i, pkg := range server.Packages {
+		connection, err := packageConnection(pkg)
+		if err != nil {
+			return nil, fmt.Errorf("packages[%d]: %w", i, err)
+		}
+		data.Connections = append(data.Connections, connection)
+	}
+	for i, remote := range server.Remotes {
+		if remote.Type == moduledata.MCPServerConnectionTypeStdio || remote.URL == "" {
+			return nil, fmt.Errorf("remotes[%d]: a remote requires an HTTP transport and a url", i)
+		}
+		connection, err := transportConnection(remote)
+		if err != nil {
+			return nil, fmt.Errorf("remotes[%d]: %w", i, err)
+		}
+		data.Connections = append(data.Connections, connection)
+	}
+	if len(data.Connections) == 0 {
+		return nil, fmt.Errorf("invalid server.json: at least one package or remote is required")
+	}
+
+	if capabilities == nil {
+		return data, nil
+	}
+	for _, tool := range capabilities.Tools {
+		data.Tools = append(data.Tools, convertTool(tool))
+	}
+	for _, prompt := range capabilities.Prompts {
+		converted := moduledata.MCPServerPrompt{
+			Name:        prompt.Name,
+			Description: prompt.Description,
+			// Prompts are invoked by name.
+			Command: prompt.Name,
+		}
+		for _, argument := range prompt.Arguments {
+			converted.Args = append(converted.Args, argument.Name)
+		}
+		data.Prompts = append(data.Prompts, converted)
+	}
+	for _, resource := range capabilities.Resources {
+		data.Resources = append(data.Resources, convertResource(len(data.Resources), ResourceTemplate{
+			Name:        resource.Name,
+			Title:       resource.Title,
+			Description: resource.Description,
+			MIMEType:    resource.MIMEType,
+			Annotations: resource.Annotations,
+		}, resource.URI, "", report))
+	}
+	for _, template := range capabilities.ResourceTemplates {
+		data.Resources = append(data.Resources, convertResource(len(data.Resources), template, "", template.URITemplate, report))
+	}
+	return data, nil
+}
+
+func packageConnection(pkg Package) (moduledata.MCPServerConnection, error) {
+	connection, err := transportConnection(pkg.Transport)
+	if err != nil {
+		return connection, err
+	}
+
+	known, ok := runtimes[pkg.RegistryType]
+	connection.Command = pkg.RuntimeHint
+	if connection.Command == "" {
+		if !ok {
+			return connection, fmt.Errorf("no command is known for registry type %q, a runtimeHint is required", pkg.RegistryType)
+		}
+		connection.Command = known.command
+	}
+	if len(pkg.RuntimeArguments) == 0 && connection.Command == known.command {
+		connection.Args = slices.Clone(known.args)
+	}
+	connection.Args = appendArguments(connection.Args, pkg.RuntimeArguments)
+	identifier := pkg.Identifier
+	if pkg.Version != "" && ok {
+		identifier += known.separator + pkg.Version
+	}
+	connection.Args = append(connection.Args, identifier)
+	connection.Args = appendArguments(connection.Args, pkg.PackageArguments)
+
+	for _, variable := range pkg.EnvironmentVariables {
+		envVar := moduledata.EnvVar{
+			Name:         variable.Name,
+			Description:  variable.Description,
+			DefaultValue: variable.Default,
+		}
+		if variable.IsRequired {
+			envVar.Required = &variable.IsRequired
+		}
+		connection.EnvVars = append(connection.EnvVars, envVar)
+	}
+	return connection, nil
+}
+
+func transportConnection(transport Transport) (moduledata.MCPServerConnection, error) {
+	connection := moduledata.MCPServerConnection{Type: transport.Type, URL: transport.URL}
+	switch transport.Type {
+	case moduledata.MCPServerConnectionTypeStdio, moduledata.MCPServerConnectionTypeStreamableHTTP, moduledata.MCPServerConnectionTypeSSE:
+	default:
+		return connection, fmt.Errorf("unsupported transport type %q", transport.Type)
+	}
+	for _, header := range transport.Headers {
+		if connection.Headers == nil {
+			connection.Headers = map[string]string{}
+		}
+		connection.Headers[header.Name] = header.Value
+	}
+	return connection, nil
+}
+
+// appendArguments appends the command-line form of the arguments. Arguments
+// without a value fall back to their default, then to their value hint.
+func appendArguments(args []string, arguments []Argument) []string {
+	for _, argument := range arguments {
+		value := argument.Value
+		if value == "" {
+			value = argument.Default
+		}
+		if value == "" && argument.ValueHint != "" {
+			value = "<" + argument.ValueHint + ">"
+		}
+		if argument.Type == "named" {
+			args = append(args, argument.Name)
+		}
+		if value != "" {
+			args = append(args, value)
+		}
+	}
+	return args
+}
+
+func convertTool(tool Tool) moduledata.MCPServerTool {
+	converted := moduledata.MCPServerTool{
+		Name:        tool.Name,
+		Title:       tool.Title,
+		Description: tool.Description,
+	}
+	if annotations := tool.Annotations; annotations != nil {
+		if converted.Title == "" {
+			converted.Title = annotations.Title
+		}
+		for _, scope := range []struct {
+			hint  bool
+			scope string
+		}{
+			{annotations.ReadOnlyHint, moduledata.MCPServerToolScopesReadOnly},
+			{annotations.DestructiveHint, moduledata.MCPServerToolScopesDestructive},
+			{annotations.IdempotentHint, moduledata.MCPServerToolScopesIdempotent},
+			{annotations.OpenWorldHint, moduledata.MCPServerToolScopesExternal},
+		} {
+			if scope.hint {
+				converted.Scopes = append(converted.Scopes, scope.scope)
+			}
+		}
+	}
+	return converted
+}
+
+// convertResource converts the i-th resource of the module data. The required
+// audience defaults to DefaultAudience, which is reported as a guess.
+func convertResource(i int, resource ResourceTemplate, uri, uriTemplate string, report *Report) moduledata.MCPServerResource {
+	converted := moduledata.MCPServerResource{
+		Name:        resource.Name,
+		Title:       resource.Title,
+		Description: resource.Description,
+		MIMEType:    resource.MIMEType,
+		URI:         uri,
+		URITemplate: uriTemplate,
+	}
+	if annotations := resource.Annotations; annotations != nil {
+		converted.Audience = slices.Clone(annotations.Audience)
+		converted.Priority = annotations.Priority
+	}
+	if len(converted.Audience) == 0 {
+		converted.Audience = slices.Clone(DefaultAudience)
+		report.guess(fmt.Sprintf("resources[%d].audience", i), strings.Join(DefaultAudience, ", "),
+			"the resource declares no audience, so it is meant for the user and the assistant")
+	}
+	return converted
+}
+
+// publisherCapabilities reads the capabilities from the publisher-provided
+// _meta of a server, if any.
+func publisherCapabilities(meta map[string]any) (*Capabilities, error) {
+	provided, ok := meta[PublisherProvidedMeta]
+	if !ok {
+		return nil, nil
+	}
+	data, err := json.Marshal(provided)
+	if err != nil {
+		return nil, err
+	}
+	capabilities := &Capabilities{}
+	if err := json.Unmarshal(data, capabilities); err != nil {
+		return nil, fmt.Errorf("invalid server.json: _meta %s: %w", PublisherProvidedMeta, err)
+	}
+	return capabilities, nil
+}
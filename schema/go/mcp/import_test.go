@@ -0,0 +1,171 @@
+package mcp_test
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/agntcy/oasf/proto/go/descriptor"
+	"github.com/agntcy/oasf/schema/go/mcp"
+	"github.com/agntcy/oasf/schema/go/moduledata"
+	"github.com/agntcy/oasf/schema/go/oasf"
+	"github.com/agntcy/oasf/schema/go/validator"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const schemaDir = "../.."
+
+var serverPath = filepath.Join("testdata", "server.json")
+
+func readServer() map[string]any {
+	data, err := os.ReadFile(serverPath)
+	Expect(err).NotTo(HaveOccurred())
+	var server map[string]any
+	Expect(json.Unmarshal(data, &server)).To(Succeed())
+	return server
+}
+
+var _ = Describe("MCP server import", func() {
+	var (
+		schema   *oasf.Schema
+		importer *mcp.Importer
+	)
+
+	importServer := func(server map[string]any) error {
+		data, err := json.Marshal(server)
+		Expect(err).NotTo(HaveOccurred())
+		_, _, err = importer.Import(data)
+		return err
+	}
+
+	BeforeEach(func() {
+		var err error
+		schema, err = oasf.Load(schemaDir)
+		Expect(err).NotTo(HaveOccurred())
+		importer = mcp.NewImporter(schema)
+	})
+
+	It("should import the connections of every package and remote", func() {
+		module, _, err := importer.ImportFile(serverPath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(module.GetName()).To(Equal("integration/mcp"))
+		Expect(module.GetId()).To(BeEquivalentTo(202))
+
+		data, err := moduledata.DecodeMCPData(module)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(data.Name).To(Equal("io.github.agntcy/weather"))
+		Expect(data.Description).To(Equal("Current weather and forecasts for any location."))
+		Expect(data.MCPData).To(BeNil(), "the original payload belongs to the artifact")
+
+		required := true
+		Expect(data.Connections).To(Equal([]moduledata.MCPServerConnection{
+			{
+				Type:    "stdio",
+				Command: "npx",
+				Args:    []string{"-y", "@agntcy/weather-mcp@1.2.0", "--units", "metric"},
+				EnvVars: []moduledata.EnvVar{{Name: "WEATHER_API_KEY", Description: "API key of the weather provider.", Required: &required}},
+			},
+			{
+				Type:    "streamable-http",
+				URL:     "http://localhost:8080/mcp",
+				Command: "docker",
+				Args:    []string{"run", "-i", "--rm", "ghcr.io/agntcy/weather-mcp:1.2.0"},
+			},
+			{
+				Type:    "streamable-http",
+				URL:     "https://weather.agntcy.org/mcp",
+				Headers: map[string]string{"Authorization": "Bearer {token}"},
+			},
+		}))
+	})
+
+	It("should import the capabilities of the publisher-provided metadata", func() {
+		module, report, err := importer.ImportFile(serverPath)
+		Expect(err).NotTo(HaveOccurred())
+		data, err := moduledata.DecodeMCPData(module)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(data.Tools).To(Equal([]moduledata.MCPServerTool{{
+			Name:        "get_forecast",
+			Title:       "Get Forecast",
+			Description: "Get the forecast of a location.",
+			Scopes:      []string{"read_only", "external"},
+		}}))
+		Expect(data.Prompts).To(Equal([]moduledata.MCPServerPrompt{{
+			Name:        "plan_trip",
+			Description: "Plan a trip around the weather.",
+			Command:     "plan_trip",
+			Args:        []string{"destination"},
+		}}))
+		priority := 0.5
+		Expect(data.Resources).To(Equal([]moduledata.MCPServerResource{
+			{Name: "stations", URI: "weather://stations", MIMEType: "application/json", Audience: []string{"assistant"}, Priority: &priority},
+			{Name: "station", URITemplate: "weather://stations/{id}", Description: "Observations of a weather station.", Audience: []string{"user", "assistant"}},
+		}))
+		Expect(report.Guesses).To(Equal([]mcp.Guess{{
+			Field:  "resources[1].audience",
+			Value:  "user, assistant",
+			Reason: "the resource declares no audience, so it is meant for the user and the assistant",
+		}}))
+
+		module, report, err = mcp.NewImporter(schema, mcp.WithCapabilities(mcp.Capabilities{
+			Tools: []mcp.Tool{{Name: "get_alerts"}},
+		})).ImportFile(serverPath)
+		Expect(err).NotTo(HaveOccurred())
+		data, err = moduledata.DecodeMCPData(module)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(data.Tools).To(Equal([]moduledata.MCPServerTool{{Name: "get_alerts"}}))
+		Expect(data.Prompts).To(BeEmpty())
+		Expect(report.Guesses).To(BeEmpty())
+	})
+
+	It("should store the original server.json as the module artifact", func() {
+		original, err := os.ReadFile(serverPath)
+		Expect(err).NotTo(HaveOccurred())
+		module, _, err := importer.Import(original)
+		Expect(err).NotTo(HaveOccurred())
+
+		artifact := module.GetArtifact()
+		Expect(artifact.GetMediaType()).To(Equal("application/json"))
+		Expect(artifact.GetArtifactType()).To(Equal(mcp.ArtifactType))
+		Expect(artifact.GetData()).To(Equal(original))
+		Expect(descriptor.NewVerifier().Verify(context.Background(), artifact)).To(Succeed())
+	})
+
+	It("should produce module data valid against the schema", func() {
+		module, _, err := importer.ImportFile(serverPath)
+		Expect(err).NotTo(HaveOccurred())
+		encoded, err := protojson.Marshal(module.GetData())
+		Expect(err).NotTo(HaveOccurred())
+		var data map[string]any
+		Expect(json.Unmarshal(encoded, &data)).To(Succeed())
+
+		result := validator.New(schema).ValidateObject("mcp_data", data)
+		Expect(result.Errors).To(BeEmpty())
+	})
+
+	It("should reject invalid servers", func() {
+		server := readServer()
+		delete(server, "name")
+		Expect(importServer(server)).To(MatchError("invalid server.json: name is required"))
+
+		server = readServer()
+		delete(server, "packages")
+		delete(server, "remotes")
+		Expect(importServer(server)).To(MatchError("invalid server.json: at least one package or remote is required"))
+
+		server = readServer()
+		server["remotes"] = []any{map[string]any{"type": "websocket", "url": "wss://weather.agntcy.org"}}
+		Expect(importServer(server)).To(MatchError(`remotes[0]: unsupported transport type "websocket"`))
+
+		server = readServer()
+		server["packages"] = []any{map[string]any{"registryType": "mcpb", "identifier": "https://example.com/weather.mcpb", "transport": map[string]any{"type": "stdio"}}}
+		Expect(importServer(server)).To(MatchError(`packages[0]: no command is known for registry type "mcpb", a runtimeHint is required`))
+
+		_, _, err := importer.Import([]byte(`{"name": `))
+		Expect(err).To(MatchError(ContainSubstring("invalid server.json")))
+	})
+})
@@ -0,0 +1,132 @@
+package mcp
+
+// Server is the subset of an MCP server.json document, as defined by the MCP
+// registry server schema (2025-09-29), that the importer reads.
+type Server struct {
+	Name        string         `json:"name"`
+	Title       string         `json:"title,omitempty"`
+	Description string         `json:"description,omitempty"`
+	Version     string         `json:"version,omitempty"`
+	WebsiteURL  string         `json:"websiteUrl,omitempty"`
+	Repository  *Repository    `json:"repository,omitempty"`
+	Packages    []Package      `json:"packages,omitempty"`
+	Remotes     []Transport    `json:"remotes,omitempty"`
+	Meta        map[string]any `json:"_meta,omitempty"`
+}
+
+// Repository is the source code repository of a server.
+type Repository struct {
+	URL       string `json:"url"`
+	Source    string `json:"source,omitempty"`
+	Subfolder string `json:"subfolder,omitempty"`
+}
+
+// Package is a package distribution of a server, run locally.
+type Package struct {
+	RegistryType         string          `json:"registryType"`
+	RegistryBaseURL      string          `json:"registryBaseUrl,omitempty"`
+	Identifier           string          `json:"identifier"`
+	Version              string          `json:"version,omitempty"`
+	RuntimeHint          string          `json:"runtimeHint,omitempty"`
+	Transport            Transport       `json:"transport"`
+	RuntimeArguments     []Argument      `json:"runtimeArguments,omitempty"`
+	PackageArguments     []Argument      `json:"packageArguments,omitempty"`
+	EnvironmentVariables []KeyValueInput `json:"environmentVariables,omitempty"`
+}
+
+// Transport is the transport of a package or a remote endpoint.
+type Transport struct {
+	Type    string          `json:"type"`
+	URL     string          `json:"url,omitempty"`
+	Headers []KeyValueInput `json:"headers,omitempty"`
+}
+
+// Argument is a runtime or package argument of a package.
+type Argument struct {
+	// Type is positional or named.
+	Type      string `json:"type"`
+	Name      string `json:"name,omitempty"`
+	Value     string `json:"value,omitempty"`
+	ValueHint string `json:"valueHint,omitempty"`
+	Default   string `json:"default,omitempty"`
+}
+
+// KeyValueInput is an environment variable or header of a server.
+type KeyValueInput struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Value       string `json:"value,omitempty"`
+	Default     string `json:"default,omitempty"`
+	IsRequired  bool   `json:"isRequired,omitempty"`
+	IsSecret    bool   `json:"isSecret,omitempty"`
+}
+
+// Capabilities are the tools, prompts and resources of a server, in the format
+// of the MCP tools/list, prompts/list, resources/list and
+// resources/templates/list results. server.json does not declare them, so
+// they are read from the publisher-provided _meta of the server or given with
+// WithCapabilities.
+type Capabilities struct {
+	Tools             []Tool             `json:"tools,omitempty"`
+	Prompts           []Prompt           `json:"prompts,omitempty"`
+	Resources         []Resource         `json:"resources,omitempty"`
+	ResourceTemplates []ResourceTemplate `json:"resourceTemplates,omitempty"`
+}
+
+// Tool is an MCP tool.
+type Tool struct {
+	Name        string           `json:"name"`
+	Title       string           `json:"title,omitempty"`
+	Description string           `json:"description,omitempty"`
+	Annotations *ToolAnnotations `json:"annotations,omitempty"`
+}
+
+// ToolAnnotations are the behavior hints of an MCP tool.
+type ToolAnnotations struct {
+	Title           string `json:"title,omitempty"`
+	ReadOnlyHint    bool   `json:"readOnlyHint,omitempty"`
+	DestructiveHint bool   `json:"destructiveHint,omitempty"`
+	IdempotentHint  bool   `json:"idempotentHint,omitempty"`
+	OpenWorldHint   bool   `json:"openWorldHint,omitempty"`
+}
+
+// Prompt is an MCP prompt.
+type Prompt struct {
+	Name        string           `json:"name"`
+	Title       string           `json:"title,omitempty"`
+	Description string           `json:"description,omitempty"`
+	Arguments   []PromptArgument `json:"arguments,omitempty"`
+}
+
+// PromptArgument is an argument of an MCP prompt.
+type PromptArgument struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Required    bool   `json:"required,omitempty"`
+}
+
+// Resource is an MCP resource.
+type Resource struct {
+	URI         string               `json:"uri"`
+	Name        string               `json:"name"`
+	Title       string               `json:"title,omitempty"`
+	Description string               `json:"description,omitempty"`
+	MIMEType    string               `json:"mimeType,omitempty"`
+	Annotations *ResourceAnnotations `json:"annotations,omitempty"`
+}
+
+// ResourceTemplate is an MCP resource template.
+type ResourceTemplate struct {
+	URITemplate string               `json:"uriTemplate"`
+	Name        string               `json:"name"`
+	Title       string               `json:"title,omitempty"`
+	Description string               `json:"description,omitempty"`
+	MIMEType    string               `json:"mimeType,omitempty"`
+	Annotations *ResourceAnnotations `json:"annotations,omitempty"`
+}
+
+// ResourceAnnotations are the audience and priority of an MCP resource.
+type ResourceAnnotations struct {
+	Audience []string `json:"audience,omitempty"`
+	Priority *float64 `json:"priority,omitempty"`
+}
@@ -0,0 +1,735 @@
+// Code generated by moduledata-gen. DO NOT EDIT.
+
+package moduledata
+
+import typesv1 "github.com/agntcy/oasf/proto/go/agntcy/oasf/types/v1"
+
+// SchemaVersion is the version of the schema the types were generated from.
+const SchemaVersion = "1.2.0-dev"
+
+var modules = map[string]func() any{
+	"core/evaluation":                 func() any { return new(EvaluationData) },
+	"core/language_model":             func() any { return new(LanguageModelData) },
+	"core/language_model/agentskills": func() any { return new(AgentskillsData) },
+	"core/language_model/prompt":      func() any { return new(LanguageModelPromptData) },
+	"core/observability":              func() any { return new(ObservabilityData) },
+	"integration/a2a":                 func() any { return new(A2AData) },
+	"integration/acp":                 func() any { return new(ACPManifestData) },
+	"integration/agentspec":           func() any { return new(AgentspecData) },
+	"integration/mcp":                 func() any { return new(MCPData) },
+}
+
+// DecodeEvaluationData decodes the data of a module core/evaluation (id 102).
+func DecodeEvaluationData(module *typesv1.Module) (*EvaluationData, error) {
+	data := new(EvaluationData)
+	if err := Decode(module, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// EncodeEvaluationData stores data as the data of a module core/evaluation.
+func EncodeEvaluationData(module *typesv1.Module, data *EvaluationData) error {
+	return Encode(module, data)
+}
+
+// DecodeLanguageModelData decodes the data of a module core/language_model (id 103).
+func DecodeLanguageModelData(module *typesv1.Module) (*LanguageModelData, error) {
+	data := new(LanguageModelData)
+	if err := Decode(module, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// EncodeLanguageModelData stores data as the data of a module core/language_model.
+func EncodeLanguageModelData(module *typesv1.Module, data *LanguageModelData) error {
+	return Encode(module, data)
+}
+
+// DecodeAgentskillsData decodes the data of a module core/language_model/agentskills (id 10302).
+func DecodeAgentskillsData(module *typesv1.Module) (*AgentskillsData, error) {
+	data := new(AgentskillsData)
+	if err := Decode(module, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// EncodeAgentskillsData stores data as the data of a module core/language_model/agentskills.
+func EncodeAgentskillsData(module *typesv1.Module, data *AgentskillsData) error {
+	return Encode(module, data)
+}
+
+// DecodeLanguageModelPromptData decodes the data of a module core/language_model/prompt (id 10301).
+func DecodeLanguageModelPromptData(module *typesv1.Module) (*LanguageModelPromptData, error) {
+	data := new(LanguageModelPromptData)
+	if err := Decode(module, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// EncodeLanguageModelPromptData stores data as the data of a module core/language_model/prompt.
+func EncodeLanguageModelPromptData(module *typesv1.Module, data *LanguageModelPromptData) error {
+	return Encode(module, data)
+}
+
+// DecodeObservabilityData decodes the data of a module core/observability (id 101).
+func DecodeObservabilityData(module *typesv1.Module) (*ObservabilityData, error) {
+	data := new(ObservabilityData)
+	if err := Decode(module, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// EncodeObservabilityData stores data as the data of a module core/observability.
+func EncodeObservabilityData(module *typesv1.Module, data *ObservabilityData) error {
+	return Encode(module, data)
+}
+
+// DecodeA2AData decodes the data of a module integration/a2a (id 203).
+func DecodeA2AData(module *typesv1.Module) (*A2AData, error) {
+	data := new(A2AData)
+	if err := Decode(module, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// EncodeA2AData stores data as the data of a module integration/a2a.
+func EncodeA2AData(module *typesv1.Module, data *A2AData) error {
+	return Encode(module, data)
+}
+
+// DecodeACPManifestData decodes the data of a module integration/acp (id 201).
+func DecodeACPManifestData(module *typesv1.Module) (*ACPManifestData, error) {
+	data := new(ACPManifestData)
+	if err := Decode(module, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// EncodeACPManifestData stores data as the data of a module integration/acp.
+func EncodeACPManifestData(module *typesv1.Module, data *ACPManifestData) error {
+	return Encode(module, data)
+}
+
+// DecodeAgentspecData decodes the data of a module integration/agentspec (id 204).
+func DecodeAgentspecData(module *typesv1.Module) (*AgentspecData, error) {
+	data := new(AgentspecData)
+	if err := Decode(module, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// EncodeAgentspecData stores data as the data of a module integration/agentspec.
+func EncodeAgentspecData(module *typesv1.Module, data *AgentspecData) error {
+	return Encode(module, data)
+}
+
+// DecodeMCPData decodes the data of a module integration/mcp (id 202).
+func DecodeMCPData(module *typesv1.Module) (*MCPData, error) {
+	data := new(MCPData)
+	if err := Decode(module, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// EncodeMCPData stores data as the data of a module integration/mcp.
+func EncodeMCPData(module *typesv1.Module, data *MCPData) error {
+	return Encode(module, data)
+}
+
+// A2AData is the A2A Data object. Data for the A2A record module.
+type A2AData struct {
+	// The A2A card data structure itself that contains information about the
+	// agent's capabilities and communication details.
+	CardData any `json:"card_data"`
+	// Version of the A2A card schema used for the card_data.
+	CardSchemaVersion string `json:"card_schema_version"`
+}
+
+// ACPAgentDependency is the Agent Dependency object. Reference to an Agent
+// Manifest, it includes name, version and a locator.
+type ACPAgentDependency struct {
+	// Name of selected deployment option for this agent.
+	DeploymentOption string `json:"deployment_option,omitempty"`
+	// Environment variable values to be set for this agent.
+	EnvVarValues []EnvVarValues `json:"env_var_values,omitempty"`
+	// Name of the agent dependency.
+	Name string `json:"name"`
+	// Reference to the agent in the agent directory. It includes the version and
+	// the locator.
+	Ref *ACPAgentReference `json:"ref"`
+}
+
+// ACPAgentReference is the Agent Manifest Reference object. Reference to an
+// Agent Manifest, it includes name, version and a locator.
+type ACPAgentReference struct {
+	// A locator provides an actual artifact locator. For example, this can
+	// reference sources such as helm charts, docker images, binaries, and so on.
+	Locator *Locator `json:"locator,omitempty"`
+	// Name of the agent that identifies the agent in its manifest.
+	Name string `json:"name"`
+	// Version of the agent in its manifest. Should be formatted according to <a
+	// target='_blank' href='https://semver.org' >Semantic Versioning</a>.
+	Version string `json:"version"`
+}
+
+// ACPCapabilities is the Agent Capabilities object. Declares what invocation
+// features this agent is capable of.
+type ACPCapabilities struct {
+	// This is `true` if the agent supports a webhook to report run results. If
+	// this is `false`, providing a `webhook` at run creation has no effect. If
+	// missing, it means `false`.
+	Callbacks *bool `json:"callbacks,omitempty"`
+	// This is `true` if the agent runs can interrupt to request additional input
+	// and can be subsequently resumed. If missing, it means `false`.
+	InterruptSupport *bool `json:"interrupt_support,omitempty"`
+	// Supported streaming modes. If missing, streaming is not supported. If no
+	// mode is supported attempts to stream output will result in an error.
+	Streaming *ACPStreamingModes `json:"streaming,omitempty"`
+	// This is `true` if the agent supports run threads. If this is `false`, then
+	// the threads tagged with `Threads` are not available. If missing, it means
+	// `false`.
+	Threads *bool `json:"threads,omitempty"`
+}
+
+// ACPDeployment is the Agent Workflow Server Deployment Manifest object.
+// Describe all the details needed to deploy an agent by the Agent Workflow
+// Server.
+type ACPDeployment struct {
+	// List of all other agents this agent depends on.
+	AgentDeps []ACPAgentDependency `json:"agent_deps,omitempty"`
+	// List of possible methods to instantiate or consume the agent. Any of the
+	// available option could be used. Every option could be associated with a
+	// unique name within this agent. If present, when another manifest refers to
+	// this manifest, it can also select the preferred deployment option.
+	DeploymentOptions []map[string]any `json:"deployment_options"`
+	// List of environment variables to be set for the agent.
+	EnvVars []EnvVar `json:"env_vars,omitempty"`
+}
+
+// ACPInterrupts is the Interrupts object. List of possible interrupts that can
+// be provided by the agent. If `interrupts` capability is true, this needs to
+// have at least one item.
+type ACPInterrupts struct {
+	// An instance of an OpenAPI schema object, formatted as per the OpenAPI specs.
+	InterruptPayload any `json:"interrupt_payload"`
+	// Name of this interrupt type. Needs to be unique in the list of interrupts.
+	InterruptType string `json:"interrupt_type"`
+	// An instance of an OpenAPI schema object, formatted as per the OpenAPI specs.
+	ResumePayload any `json:"resume_payload"`
+}
+
+// ACPManifestData is the Manifest Data object. Agent manifest data
+type ACPManifestData struct {
+	// Specification of agent capabilities, config, input, output, and interrupts.
+	ACP *AgentConnectProtocol `json:"acp"`
+	// Describe all the details needed to deploy an agent by the Agent Workflow
+	// Server.
+	Deployment *ACPDeployment `json:"deployment"`
+}
+
+// ACPStreamingModes is the Streaming Modes object. Supported streaming modes.
+// If missing, streaming is not supported. If no mode is supported attempts to
+// stream output will result in an error.
+type ACPStreamingModes struct {
+	// This is `true` if the agent supports custom objects streaming. If `false` or
+	// missing, custom streaming is not supported. Custom Objects streaming
+	// consists of a stream of object whose schema is specified by the agent in its
+	// manifest under `specs.custom_streaming_update`.
+	CustomObjectsStreaming *bool `json:"custom_objects_streaming,omitempty"`
+	// This is `true` if the agent supports result streaming. If `false` or
+	// missing, result streaming is not supported. Result streaming consists of a
+	// stream of objects of type `RunResult`, where each one sent over the stream
+	// fully replace the previous one.
+	ResultStreaming *bool `json:"result_streaming,omitempty"`
+}
+
+// AgentConnectProtocol is the Agent Connect Protocol Specs object.
+// Specification of agent capabilities, config, input, output, and interrupts.
+type AgentConnectProtocol struct {
+	// Declares what invocation features this agent is capable of.
+	Capabilities *ACPCapabilities `json:"capabilities"`
+	// An instance of an OpenAPI schema object, formatted as per the OpenAPI specs.
+	Config any `json:"config"`
+	// This describes the format of an Update in the streaming. Must be specified
+	// if `streaming.custom` capability is true and cannot be specified otherwise.
+	// Format follows the OpenAPI Schema Object.
+	CustomStreamingUpdate any `json:"custom_streaming_update,omitempty"`
+	// An instance of an OpenAPI schema object, formatted as per the OpenAPI specs.
+	Input any `json:"input"`
+	// List of possible interrupts that can be provided by the agent. If
+	// `interrupts` capability is true, this needs to have at least one item.
+	Interrupts []ACPInterrupts `json:"interrupts,omitempty"`
+	// An instance of an OpenAPI schema object, formatted as per the OpenAPI specs.
+	Output any `json:"output"`
+	// This describes the format of ThreadState. Cannot be specified if `threads`
+	// capability is false. If not specified, when `threads` capability is true,
+	// then the API to retrieve ThreadState from a Thread or a Run is not
+	// available. This object contains an instance of an OpenAPI schema object,
+	// formatted as per the OpenAPI schema object specs.
+	ThreadState any `json:"thread_state,omitempty"`
+}
+
+// AgentskillsArtifact is the Agent Skill Artifact object. A file or resource
+// referenced by a skill package.
+type AgentskillsArtifact struct {
+	// Optional digest for artifact integrity verification.
+	ArtifactHash string `json:"artifact_hash,omitempty"`
+	// Description of the artifact purpose.
+	Description string `json:"description,omitempty"`
+	// External locator when the artifact is hosted outside of the skill package.
+	Locator *Locator `json:"locator,omitempty"`
+	// Relative path of the artifact inside the skill package.
+	Path string `json:"path"`
+	// Indicates whether this artifact is required for skill execution.
+	Required *bool `json:"required,omitempty"`
+	// Declared artifact type.
+	Type string `json:"type"`
+}
+
+// Values of AgentskillsArtifact.Type.
+const (
+	AgentskillsArtifactTypeAsset     = "asset"
+	AgentskillsArtifactTypeOther     = "other"
+	AgentskillsArtifactTypeReference = "reference"
+	AgentskillsArtifactTypeScript    = "script"
+	AgentskillsArtifactTypeTemplate  = "template"
+	AgentskillsArtifactTypeWorkflow  = "workflow"
+)
+
+// AgentskillsData is the Agent Skills Data object. Data for the Language Model
+// Agent Skills module, including parsed SKILL.md metadata and validation
+// outcomes.
+type AgentskillsData struct {
+	// Referenced artifacts used by the skill (scripts, references, assets, and
+	// templates).
+	Artifacts []AgentskillsArtifact `json:"artifacts,omitempty"`
+	// Capabilities exposed by the skill.
+	Capabilities []string `json:"capabilities,omitempty"`
+	// Path to the skill definition file, typically SKILL.md.
+	SkillFile string `json:"skill_file,omitempty"`
+	// Structured SKILL.md metadata extracted from the skill package.
+	SkillManifest *AgentskillsManifest `json:"skill_manifest,omitempty"`
+	// Location of the Agent Skills package (repository, registry, or local
+	// artifact).
+	SourceLocator *Locator `json:"source_locator,omitempty"`
+	// Revision identifier (commit, tag, or digest) used to resolve the skill
+	// source.
+	SourceRevision string `json:"source_revision,omitempty"`
+	// Version of the Agent Skills standard used for validation.
+	StandardVersion string `json:"standard_version,omitempty"`
+	// Validation results for SKILL.md and referenced artifacts against the Agent
+	// Skills standard.
+	Validation *AgentskillsValidation `json:"validation,omitempty"`
+}
+
+// AgentskillsManifest is the Agent Skill Manifest object. Normalized metadata
+// extracted from a SKILL.md file.
+type AgentskillsManifest struct {
+	// Tool names explicitly allowed by the skill metadata.
+	AllowedTools []string `json:"allowed_tools,omitempty"`
+	// Compatibility declarations for agent runtimes and platforms.
+	Compatibility []string `json:"compatibility,omitempty"`
+	// The skill description declared in SKILL.md metadata.
+	Description string `json:"description"`
+	// Additional SKILL.md frontmatter metadata not modeled as top-level fields.
+	FrontmatterMetadata any `json:"frontmatter_metadata,omitempty"`
+	// License declared for the skill package.
+	License string `json:"license,omitempty"`
+	// The skill name declared in SKILL.md metadata.
+	Name string `json:"name"`
+	// The skill version declared in SKILL.md metadata.
+	Version string `json:"version,omitempty"`
+}
+
+// AgentskillsValidation is the Agent Skill Validation object. Validation status
+// and details for SKILL.md and referenced artifacts.
+type AgentskillsValidation struct {
+	// Indicates whether referenced artifacts passed integrity and presence checks.
+	ArtifactsValid *bool `json:"artifacts_valid,omitempty"`
+	// Indicates whether SKILL.md passed validation against the standard.
+	SkillMDValid bool `json:"skill_md_valid"`
+	// Timestamp when validation was executed.
+	ValidatedAt string `json:"validated_at"`
+	// Validation errors emitted by the validator.
+	ValidationErrors []string `json:"validation_errors,omitempty"`
+	// Optional URL for a full validation report artifact.
+	ValidationReportURL string `json:"validation_report_url,omitempty"`
+	// Overall validation status.
+	ValidationStatus string `json:"validation_status"`
+	// Validation warnings emitted by the validator.
+	ValidationWarnings []string `json:"validation_warnings,omitempty"`
+	// Validator implementation used for the checks (for example, skills-ref).
+	Validator string `json:"validator"`
+}
+
+// Values of AgentskillsValidation.ValidationStatus.
+const (
+	AgentskillsValidationValidationStatusFailed  = "failed"
+	AgentskillsValidationValidationStatusPassed  = "passed"
+	AgentskillsValidationValidationStatusUnknown = "unknown"
+	AgentskillsValidationValidationStatusWarning = "warning"
+)
+
+// AgentspecData is the Agent Spec Data object. Data for the Open Agent Spec
+// record module.
+type AgentspecData struct {
+	// Location of the Agent Spec config. E.g. path to config, github repo url etc.
+	Config *Locator `json:"config"`
+	// List of possible configuration to instantiate or consume the agent. Any of
+	// the available option could be used.
+	DeploymentOptions []AgentspecDeploymentOption `json:"deployment_options"`
+	// List of environment variables to be set for the agent.
+	EnvVars []EnvVar `json:"env_vars,omitempty"`
+	// List of locators for the non-serializable objects the Agent Spec config
+	// depends on (e.g. tool implementations).
+	RuntimeDeps []any `json:"runtime_deps,omitempty"`
+}
+
+// AgentspecDeploymentOption is the Deployment Option object. Describes a
+// deployment option for an agent.
+type AgentspecDeploymentOption struct {
+	// Name of the deployment option.
+	Name string `json:"name,omitempty"`
+	// Configuration for the protocol used to serve the Agent.
+	Protocol map[string]any `json:"protocol"`
+	// Name for the runtime framework to use to run the Agent Spec config.
+	RuntimeFramework string `json:"runtime_framework"`
+}
+
+// Values of AgentspecDeploymentOption.RuntimeFramework.
+const (
+	AgentspecDeploymentOptionRuntimeFrameworkAutogen   = "autogen"
+	AgentspecDeploymentOptionRuntimeFrameworkLanggraph = "langgraph"
+	AgentspecDeploymentOptionRuntimeFrameworkWayflow   = "wayflow"
+)
+
+// EnvVar is the Environment Variable object. Describes an environment variable.
+type EnvVar struct {
+	// Default value of the environment variable.
+	DefaultValue string `json:"default_value,omitempty"`
+	// Description of the environment variable.
+	Description string `json:"description"`
+	// Name of the environment variable.
+	Name string `json:"name"`
+	// Indicates that this environment variable is mandatory to be set.
+	Required *bool `json:"required,omitempty"`
+}
+
+// EnvVarValues is the Environment Variable Values object. Describes the values
+// of the environment variables for a specific agent and it's dependencies.
+type EnvVarValues struct {
+	// List of environment variable values to be set for the agent dependencies.
+	EnvDeps []EnvVarValues `json:"env_deps,omitempty"`
+	// Name of the agent dependency these environment variables are for.
+	Name string `json:"name,omitempty"`
+	// Environment Variable Values listed as Key / Values pairs.
+	Values []KeyValueObject `json:"values"`
+}
+
+// EvaluationData is the Evaluation Data object. Data supported by the record
+// module for evaluation.
+type EvaluationData struct {
+	// Overall rating of the agent across all evaluation.
+	OverallRating *float64 `json:"overall_rating,omitempty"`
+	// Overall scores of the agent across all evaluation.
+	OverallScores *OverallScores `json:"overall_scores,omitempty"`
+	// Evaluations associated to the agent.
+	ReferredEvaluations []ReferredEvaluation `json:"referred_evaluations"`
+}
+
+// EvaluationDataset is the Dataset object. A dataset used for agent evaluation.
+type EvaluationDataset struct {
+	// The metadata associated to the dataset.
+	Metadata []KeyValueObject `json:"metadata,omitempty"`
+	// The name of the dataset.
+	Name string `json:"name"`
+	// The URL pointing to the actual dataset.
+	URL string `json:"url"`
+	// The version of the dataset.
+	Version string `json:"version,omitempty"`
+}
+
+// EvaluationReport is the Evaluation report object. The report of an
+// evaluation.
+type EvaluationReport struct {
+	// The metrics of the associated evaluation.
+	Metrics []Metric `json:"metrics,omitempty"`
+	// The scores of the associated evaluation.
+	OverallScores *OverallScores `json:"overall_scores,omitempty"`
+}
+
+// KeyValueObject is the Key Value Object object. A generic object allowing to
+// define a <code>{key:value}</code> pair.
+type KeyValueObject struct {
+	// The name of the key.
+	Name string `json:"name"`
+	// The value associated to the key.
+	Value string `json:"value"`
+}
+
+// LanguageModel is the Language Model Configuration object. Configures the MCP
+// server for Language Model support.
+type LanguageModel struct {
+	// URL of the API base for the Language Model (e.g.,
+	// 'https://api.openai.com/v1').
+	APIBase string `json:"api_base"`
+	// Environment variables, such as API key for accessing the Language Model, if
+	// required by the provider.
+	EnvVars []EnvVar `json:"env_vars,omitempty"`
+	// Name of the Language Model including its version (e.g., 'gpt-3.5-turbo').
+	Model string `json:"model"`
+	// Provider of the Language Model (e.g., 'ollama', 'azure').
+	Provider string `json:"provider"`
+}
+
+// LanguageModelData is the Language Model Data object. Data for the Language
+// Model record module.
+type LanguageModelData struct {
+	// Collection of Language Models supported by the agent, including their
+	// configurations and parameters.
+	Models []LanguageModel `json:"models"`
+}
+
+// LanguageModelPrompt is the Language Model Prompt object. Defines the
+// structure for Language Model prompts used in agent interactions.
+type LanguageModelPrompt struct {
+	// A specific instruction given to a prompt to perform a task.
+	Command string `json:"command"`
+	// Description of the prompt, providing context and usage information.
+	Description string `json:"description"`
+	// Name of the prompt, used to identify it in the system.
+	Name string `json:"name"`
+}
+
+// LanguageModelPromptData is the Language Model Prompt Data object. Data for
+// the Language Model prompt record module.
+type LanguageModelPromptData struct {
+	// List of common prompts used for Language Model interactions with the agent,
+	// including their configurations and parameters.
+	Prompts []LanguageModelPrompt `json:"prompts"`
+}
+
+// Locator is the Locator object. Locators provide actual artifact locators of
+// the data's record. For example, this can reference sources such as Helm
+// charts, Docker images, binaries, and so on.
+type Locator struct {
+	// Additional metadata associated with the record locator.
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// Describes the type of the release manifest pointed by its URI. Allowed
+	// values MAY be defined for common manifest types.
+	Type string `json:"type"`
+	// Specifies an array of URLs from which this object MAY be downloaded. Value
+	// MUST conform to RFC 1738. Value SHOULD use the http and https schemes, as
+	// defined in RFC 7230.
+	URLs []string `json:"urls"`
+}
+
+// Values of Locator.Type.
+const (
+	LocatorTypeBinary         = "binary"
+	LocatorTypeContainerImage = "container_image"
+	LocatorTypeHelmChart      = "helm_chart"
+	LocatorTypePackage        = "package"
+	LocatorTypeSourceCode     = "source_code"
+	LocatorTypeUnspecified    = "unspecified"
+	LocatorTypeURL            = "url"
+)
+
+// MCPData is the MCP Data object. Data for the MCP record module. Represents
+// one MCP server with its capabilities and deployment options.
+type MCPData struct {
+	// List of connection configurations for accessing this server (local packages
+	// or remote endpoints).
+	Connections []MCPServerConnection `json:"connections"`
+	// Description of the server's functionality and purpose.
+	Description string `json:"description,omitempty"`
+	// The complete original MCP server JSON data structure for full fidelity
+	// storage.
+	MCPData any `json:"mcp_data,omitempty"`
+	// The name of the MCP server.
+	Name string `json:"name"`
+	// List of prompts supported by the server.
+	Prompts []MCPServerPrompt `json:"prompts,omitempty"`
+	// List of resources supported by the server.
+	Resources []MCPServerResource `json:"resources,omitempty"`
+	// List of tools supported by the server.
+	Tools []MCPServerTool `json:"tools,omitempty"`
+}
+
+// MCPServerConnection is the MCP Server Connection object. Base connection
+// configuration for accessing an MCP server.
+type MCPServerConnection struct {
+	// Command-line arguments.
+	Args []string `json:"args,omitempty"`
+	// Executable or runtime command (for stdio transport).
+	Command string `json:"command,omitempty"`
+	// Environment variables such as tokens or API keys.
+	EnvVars []EnvVar `json:"env_vars,omitempty"`
+	// HTTP headers for authentication (for HTTP-based transports).
+	Headers map[string]string `json:"headers,omitempty"`
+	// Type of the server transport.
+	Type string `json:"type"`
+	// URL of the server endpoint (required for streamable-http and sse
+	// transports).
+	URL string `json:"url,omitempty"`
+}
+
+// Values of MCPServerConnection.Type.
+const (
+	MCPServerConnectionTypeSSE            = "sse"
+	MCPServerConnectionTypeStdio          = "stdio"
+	MCPServerConnectionTypeStreamableHTTP = "streamable-http"
+)
+
+// MCPServerPrompt is the MCP Server Prompt object. Describes the configuration
+// for an MCP server prompt.
+type MCPServerPrompt struct {
+	// List of arguments for the prompt.
+	Args []string `json:"args,omitempty"`
+	// A specific instruction given to a prompt to perform a task.
+	Command string `json:"command"`
+	// Description of the prompt, providing context and usage information.
+	Description string `json:"description"`
+	// Name of the prompt, used to identify it in the system.
+	Name string `json:"name"`
+}
+
+// MCPServerResource is the MCP Server Resource object. Describes the
+// configuration for an MCP server resource.
+type MCPServerResource struct {
+	// Intended audience(s) for the resource.
+	Audience []string `json:"audience"`
+	// Description of what the resource is for.
+	Description string `json:"description,omitempty"`
+	// The MIME type of the resource.
+	MIMEType string `json:"mime_type,omitempty"`
+	// The name of the resource.
+	Name string `json:"name"`
+	// A number from 0.0 to 1.0 indicating the importance of the resource.
+	Priority *float64 `json:"priority,omitempty"`
+	// Human-readable title for the resource.
+	Title string `json:"title,omitempty"`
+	// Unique identifier for the resource.
+	URI string `json:"uri,omitempty"`
+	// The URI template of the resource.
+	URITemplate string `json:"uri_template,omitempty"`
+}
+
+// Values of MCPServerResource.Audience.
+const (
+	MCPServerResourceAudienceAssistant = "assistant"
+	MCPServerResourceAudienceUser      = "user"
+)
+
+// MCPServerTool is the MCP Server Tool object. Describes the configuration for
+// an MCP server tool.
+type MCPServerTool struct {
+	// Description of what the tool is for.
+	Description string `json:"description,omitempty"`
+	// Unique identifier for the tool.
+	Name string `json:"name"`
+	// List of scopes in which the tool can perform an action.
+	Scopes []string `json:"scopes,omitempty"`
+	// Human-readable title for the tool.
+	Title string `json:"title,omitempty"`
+}
+
+// Values of MCPServerTool.Scopes.
+const (
+	MCPServerToolScopesDestructive = "destructive"
+	MCPServerToolScopesExternal    = "external"
+	MCPServerToolScopesIdempotent  = "idempotent"
+	MCPServerToolScopesReadOnly    = "read_only"
+)
+
+// Metric is the Metric object. Defines a metric applicable to an agent,
+// capturing quantitative data for analysis and monitoring.
+type Metric struct {
+	// The actual data points collected for the metric, which can be a single value
+	// or a collection of values over time.
+	DataPoints []KeyValueObject `json:"data_points"`
+	// The unique name of the metric, identifying the specific measurement being
+	// captured (for example, 'CPU Usage' or 'Response Time').
+	Name string `json:"name"`
+	// Specifies the type of metric, such as 'counter', 'gauge', or 'histogram',
+	// which determines how the metric data is aggregated and interpreted.
+	Type string `json:"type"`
+	// The unit in which the metric value is reported. Follows the format described
+	// by <a target='_blank' href='http://unitsofmeasure.org/ucum.html'>UCUM
+	// (Unified Code for Units of Measure)</a> (for example, 'seconds', 'bytes', or
+	// 'percentage').
+	UnitOfMeasurement string `json:"unit_of_measurement"`
+	// The reference for this metric, giving some explainability for it.
+	URL string `json:"url,omitempty"`
+}
+
+// ObservabilityData is the Observability Data object. Data supported by the
+// record module for observability.
+type ObservabilityData struct {
+	// Communication protocols supported by the agent for observability.
+	CommunicationProtocols []string `json:"communication_protocols"`
+	// Data platforms supported by the agent for observability.
+	DataPlatformIntegrations []string `json:"data_platform_integrations"`
+	// Data schema supported by the agent for observability.
+	DataSchema map[string]any `json:"data_schema"`
+	// Format used by the agent for exporting observability data.
+	ExportFormat string `json:"export_format"`
+}
+
+// Values of ObservabilityData.CommunicationProtocols.
+const (
+	ObservabilityDataCommunicationProtocolsHTTP11    = "HTTP_1.1"
+	ObservabilityDataCommunicationProtocolsOtelOTPV1 = "Otel_OTP_v1"
+	ObservabilityDataCommunicationProtocolsSLIM      = "SLIM"
+	ObservabilityDataCommunicationProtocolsGRPC      = "gRPC"
+)
+
+// Values of ObservabilityData.ExportFormat.
+const (
+	ObservabilityDataExportFormatCsv  = "csv"
+	ObservabilityDataExportFormatJSON = "json"
+	ObservabilityDataExportFormatXml  = "xml"
+)
+
+// OverallScores is the Overall Scores object. Overall evaluation scores for an
+// agent.
+type OverallScores struct {
+	// Overall cost score of the agent's operation.
+	CostScore *float64 `json:"cost_score,omitempty"`
+	// Overall quality score of the agent's performance.
+	QualityScore *float64 `json:"quality_score,omitempty"`
+	// Overall security score of the agent's deployment.
+	SecurityScore *float64 `json:"security_score,omitempty"`
+}
+
+// Publisher is the Publisher object. Publisher.
+type Publisher struct {
+	// Name of the publisher.
+	Name string `json:"name"`
+	// Link to the publisher.
+	URL string `json:"url,omitempty"`
+	// Version of the publisher.
+	Version string `json:"version"`
+}
+
+// ReferredEvaluation is the Referred Evaluation object. Referred evaluation for
+// an agent.
+type ReferredEvaluation struct {
+	// Creation date of this evaluation.
+	CreatedAt string `json:"created_at"`
+	// The datasets used for this evaluation.
+	Datasets []EvaluationDataset `json:"datasets,omitempty"`
+	// The report of this evaluation.
+	EvaluationReport *EvaluationReport `json:"evaluation_report,omitempty"`
+	// The entity that published this evaluation.
+	Publisher *Publisher `json:"publisher"`
+}
@@ -0,0 +1,72 @@
+// Package moduledata provides typed Go structs for the data of OASF modules,
+// generated from the module *_data objects of the schema, and helpers to
+// convert them from and to the untyped data of a Module.
+package moduledata
+
+//go:generate go run ../cmd/moduledata-gen -schema ../.. -out moduledata.gen.go
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+
+	typesv1 "github.com/agntcy/oasf/proto/go/agntcy/oasf/types/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// New returns a pointer to a new data struct for the named module, accepting
+// hierarchical names such as integration/mcp as well as class names like mcp.
+func New(name string) (any, bool) {
+	if newData, ok := modules[name]; ok {
+		return newData(), true
+	}
+	for moduleName, newData := range modules {
+		if path.Base(moduleName) == path.Base(name) {
+			return newData(), true
+		}
+	}
+	return nil, false
+}
+
+// DecodeAny decodes the data of the module into the data struct matching its name.
+func DecodeAny(module *typesv1.Module) (any, error) {
+	data, ok := New(module.GetName())
+	if !ok {
+		return nil, fmt.Errorf("no data type is known for module '%s'", module.GetName())
+	}
+	if err := Decode(module, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// Decode unmarshals the data of the module into v. Attributes that v does not
+// declare are ignored.
+func Decode(module *typesv1.Module, v any) error {
+	if module.GetData() == nil {
+		return fmt.Errorf("module '%s' has no data", module.GetName())
+	}
+	data, err := protojson.Marshal(module.GetData())
+	if err != nil {
+		return fmt.Errorf("failed to marshal data of module '%s': %w", module.GetName(), err)
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("failed to decode data of module '%s': %w", module.GetName(), err)
+	}
+	return nil
+}
+
+// Encode replaces the data of the module with v.
+func Encode(module *typesv1.Module, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("failed to encode data of module '%s': %w", module.GetName(), err)
+	}
+	s := &structpb.Struct{}
+	if err := protojson.Unmarshal(data, s); err != nil {
+		return fmt.Errorf("failed to encode data of module '%s': %w", module.GetName(), err)
+	}
+	module.Data = s
+	return nil
+}
@@ -0,0 +1,13 @@
+package moduledata_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestModuleData(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Module Data Suite")
+}
@@ -0,0 +1,81 @@
+package moduledata_test
+
+import (
+	"os"
+
+	typesv1 "github.com/agntcy/oasf/proto/go/agntcy/oasf/types/v1"
+	"github.com/agntcy/oasf/schema/go/internal/codegen"
+	"github.com/agntcy/oasf/schema/go/moduledata"
+	"github.com/agntcy/oasf/schema/go/oasf"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const schemaDir = "../.."
+
+func mcpModule() *typesv1.Module {
+	data, err := structpb.NewStruct(map[string]any{
+		"name": "example-server",
+		"connections": []any{
+			map[string]any{
+				"type":    "stdio",
+				"command": "example-server",
+				"args":    []any{"--verbose"},
+				"headers": map[string]any{"Authorization": "Bearer token"},
+			},
+		},
+		"resources": []any{
+			map[string]any{"uri": "file:///data", "name": "data", "audience": []any{"user"}, "priority": 0.5},
+		},
+	})
+	Expect(err).NotTo(HaveOccurred())
+	return &typesv1.Module{Name: "integration/mcp", Id: 202, Data: data}
+}
+
+var _ = Describe("Module data", func() {
+	It("should be generated from the current schema", func() {
+		schema, err := oasf.Load(schemaDir)
+		Expect(err).NotTo(HaveOccurred())
+		source, err := codegen.ModuleData(schema, "moduledata")
+		Expect(err).NotTo(HaveOccurred())
+		generated, err := os.ReadFile("moduledata.gen.go")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(generated)).To(Equal(string(source)), "run go generate ./moduledata to update the generated types")
+	})
+
+	It("should decode module data into typed structs", func() {
+		data, err := moduledata.DecodeMCPData(mcpModule())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(data.Name).To(Equal("example-server"))
+		Expect(data.Connections).To(HaveLen(1))
+		Expect(data.Connections[0].Type).To(Equal(moduledata.MCPServerConnectionTypeStdio))
+		Expect(data.Connections[0].Args).To(Equal([]string{"--verbose"}))
+		Expect(data.Connections[0].Headers).To(HaveKeyWithValue("Authorization", "Bearer token"))
+		Expect(*data.Resources[0].Priority).To(Equal(0.5))
+	})
+
+	It("should encode typed structs back into the same module data", func() {
+		module := mcpModule()
+		data, err := moduledata.DecodeMCPData(module)
+		Expect(err).NotTo(HaveOccurred())
+
+		encoded := &typesv1.Module{Name: module.GetName()}
+		Expect(moduledata.EncodeMCPData(encoded, data)).To(Succeed())
+		Expect(proto.Equal(encoded.GetData(), module.GetData())).To(BeTrue())
+	})
+
+	It("should pick the data type from the module name", func() {
+		data, err := moduledata.DecodeAny(mcpModule())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(data).To(BeAssignableToTypeOf(&moduledata.MCPData{}))
+
+		data, ok := moduledata.New("a2a")
+		Expect(ok).To(BeTrue())
+		Expect(data).To(BeAssignableToTypeOf(&moduledata.A2AData{}))
+
+		_, err = moduledata.DecodeAny(&typesv1.Module{Name: "integration/unknown"})
+		Expect(err).To(MatchError(ContainSubstring("no data type is known")))
+	})
+})
@@ -0,0 +1,80 @@
+package oasf
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// SchemaFile is a single file read from the schema tree.
+type SchemaFile struct {
+	Path string
+	Data []byte
+}
+
+// SchemaCache holds every file and directory found under a schema root.
+type SchemaCache struct {
+	Root  string
+	Files []SchemaFile
+	Dirs  []string
+}
+
+// LoadCache walks dir and reads every file it contains into memory.
+// File paths are kept as returned by the walk, i.e. prefixed with dir.
+func LoadCache(dir string) (*SchemaCache, error) {
+	var files []SchemaFile
+	var dirs []string
+	err := filepath.WalkDir(dir, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			dirs = append(dirs, path)
+			return nil
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		files = append(files, SchemaFile{Path: path, Data: data})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load schema files from %s: %w", dir, err)
+	}
+	return &SchemaCache{Root: dir, Files: files, Dirs: dirs}, nil
+}
+
+// File returns the cached file with the given path, or nil if it was not loaded.
+func (c *SchemaCache) File(path string) *SchemaFile {
+	for i := range c.Files {
+		if c.Files[i].Path == path {
+			return &c.Files[i]
+		}
+	}
+	return nil
+}
+
+// JSONFiles returns the JSON files located anywhere below the given
+// subdirectory of the schema root.
+func (c *SchemaCache) JSONFiles(subdir string) []SchemaFile {
+	dir := filepath.Join(c.Root, subdir)
+	var files []SchemaFile
+	for _, file := range c.Files {
+		if !strings.HasPrefix(file.Path, dir+string(os.PathSeparator)) || filepath.Ext(file.Path) != ".json" {
+			continue
+		}
+		files = append(files, file)
+	}
+	return files
+}
+
+// Rel returns path relative to the schema root, falling back to path itself.
+func (c *SchemaCache) Rel(path string) string {
+	rel, err := filepath.Rel(c.Root, path)
+	if err != nil {
+		return path
+	}
+	return rel
+}
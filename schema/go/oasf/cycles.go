@@ -0,0 +1,129 @@
+package oasf
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// ExtendsDirs lists the schema directories whose entities may extend one another.
+var ExtendsDirs = []string{"skills", "domains", "modules", "objects"}
+
+// ExtendsNode is an entity of the extends graph of a schema directory.
+type ExtendsNode struct {
+	Name string
+	Path string
+	// Extends holds the parent names. The metaschema only allows a single
+	// parent, but lists are accepted so that malformed files are still checked.
+	Extends []string
+}
+
+// ExtendsGraph reads the extends relations declared by the JSON files of the
+// given schema directory, keyed by entity name. Files that are not valid JSON
+// or have no name are skipped; they are reported when loading the schema.
+func (c *SchemaCache) ExtendsGraph(dir string) map[string]*ExtendsNode {
+	graph := make(map[string]*ExtendsNode)
+	for _, file := range c.JSONFiles(dir) {
+		var entity struct {
+			Name    string `json:"name"`
+			Extends any    `json:"extends"`
+		}
+		if err := json.Unmarshal(file.Data, &entity); err != nil || entity.Name == "" {
+			continue
+		}
+		if graph[entity.Name] != nil {
+			continue
+		}
+		node := &ExtendsNode{Name: entity.Name, Path: c.Rel(file.Path)}
+		switch extends := entity.Extends.(type) {
+		case string:
+			if extends != "" {
+				node.Extends = []string{extends}
+			}
+		case []any:
+			for _, item := range extends {
+				if parent, ok := item.(string); ok && parent != "" {
+					node.Extends = append(node.Extends, parent)
+				}
+			}
+		}
+		graph[entity.Name] = node
+	}
+	return graph
+}
+
+// CheckCycles reports every cycle in the extends graphs of ExtendsDirs as the
+// full path of the cycle, e.g. "a (skills/a.json) → b (skills/b.json) → a".
+func (c *SchemaCache) CheckCycles() []string {
+	var errors []string
+	for _, dir := range ExtendsDirs {
+		graph := c.ExtendsGraph(dir)
+		for _, cycle := range FindCycles(graph) {
+			steps := make([]string, 0, len(cycle)+1)
+			for _, name := range cycle {
+				steps = append(steps, fmt.Sprintf("%s (%s)", name, graph[name].Path))
+			}
+			steps = append(steps, cycle[0])
+			errors = append(errors, fmt.Sprintf("Inheritance cycle in %s: %s", dir, strings.Join(steps, " → ")))
+		}
+	}
+	return errors
+}
+
+// FindCycles returns the cycles closed by back edges during a depth-first
+// search of the extends graph, so any cyclic graph yields at least one. Each
+// cycle is listed once, starting from its lexicographically smallest name.
+// Parents missing from the graph are ignored.
+func FindCycles(graph map[string]*ExtendsNode) [][]string {
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+	state := make(map[string]int, len(graph))
+	seen := make(map[string]bool)
+	var cycles [][]string
+	var path []string
+
+	var visit func(name string)
+	visit = func(name string) {
+		state[name] = visiting
+		path = append(path, name)
+		for _, parent := range graph[name].Extends {
+			if graph[parent] == nil {
+				continue
+			}
+			switch state[parent] {
+			case unvisited:
+				visit(parent)
+			case visiting:
+				cycle := rotate(path[slices.Index(path, parent):])
+				if key := strings.Join(cycle, "\x00"); !seen[key] {
+					seen[key] = true
+					cycles = append(cycles, cycle)
+				}
+			}
+		}
+		path = path[:len(path)-1]
+		state[name] = done
+	}
+
+	for _, name := range sortedKeys(graph) {
+		if state[name] == unvisited {
+			visit(name)
+		}
+	}
+	return cycles
+}
+
+// rotate returns a copy of the cycle starting from its smallest name.
+func rotate(cycle []string) []string {
+	start := 0
+	for i, name := range cycle {
+		if name < cycle[start] {
+			start = i
+		}
+	}
+	return append(slices.Clone(cycle[start:]), cycle[:start]...)
+}
@@ -0,0 +1,50 @@
+package oasf_test
+
+import (
+	"path/filepath"
+
+	"github.com/agntcy/oasf/schema/go/oasf"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Inheritance cycle detection", func() {
+	It("should find no cycles in the schema", func() {
+		cache, err := oasf.LoadCache(schemaDir)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cache.CheckCycles()).To(BeEmpty())
+	})
+
+	It("should report the full cycle path with file locations", func() {
+		cache, err := oasf.LoadCache(writeSchema(map[string]string{
+			"skills/base_skill.json": `{"name": "base_skill"}`,
+			"skills/a.json":          `{"name": "a", "extends": "c"}`,
+			"skills/b.json":          `{"name": "b", "extends": "a"}`,
+			"skills/c.json":          `{"name": "c", "extends": "b"}`,
+			"skills/d.json":          `{"name": "d", "extends": "a"}`,
+			"objects/self.json":      `{"name": "self", "extends": "self"}`,
+		}))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cache.CheckCycles()).To(Equal([]string{
+			"Inheritance cycle in skills: a (" + filepath.Join("skills", "a.json") + ") → c (" +
+				filepath.Join("skills", "c.json") + ") → b (" + filepath.Join("skills", "b.json") + ") → a",
+			"Inheritance cycle in objects: self (" + filepath.Join("objects", "self.json") + ") → self",
+		}))
+	})
+
+	It("should not report diamond-shaped extends lists", func() {
+		graph := map[string]*oasf.ExtendsNode{
+			"base":  {Name: "base"},
+			"left":  {Name: "left", Extends: []string{"base"}},
+			"right": {Name: "right", Extends: []string{"base"}},
+			"leaf":  {Name: "leaf", Extends: []string{"left", "right"}},
+		}
+		Expect(oasf.FindCycles(graph)).To(BeEmpty())
+
+		graph["base"].Extends = []string{"leaf"}
+		Expect(oasf.FindCycles(graph)).To(ConsistOf(
+			[]string{"base", "leaf", "left"},
+			[]string{"base", "leaf", "right"},
+		))
+	})
+})
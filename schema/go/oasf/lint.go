@@ -0,0 +1,114 @@
+package oasf
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// CheckUIDs reports classes that share a uid with a sibling, classes whose full
+// ids collide, module categories that share a uid, and module category classes
+// whose uid differs from their entry in module_categories.json.
+func (s *Schema) CheckUIDs() []string {
+	var errors []string
+	for _, family := range Families {
+		errors = append(errors, checkSiblingUIDs(family, s.Classes(family))...)
+		errors = append(errors, checkClassIDs(family, s.Classes(family), s.ClassIDs(family))...)
+	}
+	errors = append(errors, s.checkModuleCategoryUIDs()...)
+	return errors
+}
+
+func checkSiblingUIDs(family Family, classes map[string]*Class) []string {
+	// parent -> uid -> class paths
+	siblings := make(map[string]map[int][]string)
+	for _, name := range sortedKeys(classes) {
+		class := classes[name]
+		if class.UID == nil {
+			continue
+		}
+		if siblings[class.Extends] == nil {
+			siblings[class.Extends] = make(map[int][]string)
+		}
+		siblings[class.Extends][*class.UID] = append(siblings[class.Extends][*class.UID], class.Path)
+	}
+
+	var errors []string
+	for _, parent := range sortedKeys(siblings) {
+		for _, uid := range sortedInts(siblings[parent]) {
+			if paths := siblings[parent][uid]; len(paths) > 1 {
+				errors = append(errors, fmt.Sprintf("Duplicate uid %d among %s classes extending '%s': %v", uid, family, parent, paths))
+			}
+		}
+	}
+	return errors
+}
+
+func checkClassIDs(family Family, classes map[string]*Class, ids map[string]int) []string {
+	byID := make(map[int][]*Class)
+	for _, name := range sortedKeys(ids) {
+		byID[ids[name]] = append(byID[ids[name]], classes[name])
+	}
+
+	var errors []string
+	for _, id := range sortedInts(byID) {
+		colliding := byID[id]
+		// Id 0 is no id: classes without any uid, such as the base classes,
+		// cannot be referred to by id.
+		if id == 0 || len(colliding) < 2 || siblings(colliding) {
+			// Siblings sharing a uid are already reported by checkSiblingUIDs.
+			continue
+		}
+		var paths []string
+		for _, class := range colliding {
+			paths = append(paths, class.Path)
+		}
+		errors = append(errors, fmt.Sprintf("Duplicate %s class id %d computed for: %v", family, id, paths))
+	}
+	return errors
+}
+
+func (s *Schema) checkModuleCategoryUIDs() []string {
+	var errors []string
+	byUID := make(map[int][]string)
+	categories := s.ModuleCategories.Attributes
+	for _, name := range sortedKeys(categories) {
+		byUID[categories[name].UID] = append(byUID[categories[name].UID], name)
+	}
+	for _, uid := range sortedInts(byUID) {
+		if names := byUID[uid]; len(names) > 1 {
+			errors = append(errors, fmt.Sprintf("Duplicate uid %d in module_categories.json: %s", uid, strings.Join(names, ", ")))
+		}
+	}
+
+	for _, name := range sortedKeys(s.Modules) {
+		class := s.Modules[name]
+		category := categories[name]
+		if !class.Category || class.UID == nil || category == nil {
+			continue
+		}
+		if *class.UID != category.UID {
+			errors = append(errors, fmt.Sprintf("Module category '%s' in %s has uid %d but module_categories.json defines uid %d",
+				name, class.Path, *class.UID, category.UID))
+		}
+	}
+	return errors
+}
+
+func siblings(classes []*Class) bool {
+	for _, class := range classes[1:] {
+		if class.Extends != classes[0].Extends {
+			return false
+		}
+	}
+	return true
+}
+
+func sortedInts[V any](m map[int]V) []int {
+	keys := make([]int, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Ints(keys)
+	return keys
+}
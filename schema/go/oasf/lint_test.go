@@ -0,0 +1,63 @@
+package oasf_test
+
+import (
+	"path/filepath"
+
+	"github.com/agntcy/oasf/schema/go/oasf"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("UID checks", func() {
+	It("should accept the schema uids", func() {
+		schema, err := oasf.Load(schemaDir)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(schema.CheckUIDs()).To(BeEmpty())
+	})
+
+	It("should report siblings sharing a uid", func() {
+		schema, err := oasf.Load(writeSchema(map[string]string{
+			"skills/base_skill.json": `{"name": "base_skill", "attributes": {}}`,
+			"skills/a.json":          `{"name": "a", "uid": 1, "extends": "base_skill", "attributes": {}}`,
+			"skills/b.json":          `{"name": "b", "uid": 1, "extends": "base_skill", "attributes": {}}`,
+			"skills/c.json":          `{"name": "c", "uid": 2, "extends": "base_skill", "attributes": {}}`,
+		}))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(schema.CheckUIDs()).To(ConsistOf(
+			"Duplicate uid 1 among skill classes extending 'base_skill': " +
+				"[" + filepath.Join("skills", "a.json") + " " + filepath.Join("skills", "b.json") + "]",
+		))
+	})
+
+	It("should report colliding full ids of classes in different branches", func() {
+		schema, err := oasf.Load(writeSchema(map[string]string{
+			"domains/base_domain.json": `{"name": "base_domain", "attributes": {}}`,
+			"domains/hidden.json":      `{"name": "hidden", "extends": "base_domain", "attributes": {}}`,
+			"domains/a.json":           `{"name": "a", "uid": 3, "extends": "base_domain", "attributes": {}}`,
+			"domains/b.json":           `{"name": "b", "uid": 3, "extends": "hidden", "attributes": {}}`,
+		}))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(schema.CheckUIDs()).To(ConsistOf(
+			"Duplicate domain class id 3 computed for: " +
+				"[" + filepath.Join("domains", "a.json") + " " + filepath.Join("domains", "b.json") + "]",
+		))
+	})
+
+	It("should check module category uids", func() {
+		schema, err := oasf.Load(writeSchema(map[string]string{
+			"module_categories.json": `{"attributes": {
+				"core": {"uid": 1, "caption": "Core"},
+				"integration": {"uid": 1, "caption": "Integration"}
+			}}`,
+			"modules/base_module.json": `{"name": "base_module", "attributes": {}}`,
+			"modules/core.json":        `{"name": "core", "uid": 1, "category": true, "extends": "base_module", "attributes": {}}`,
+			"modules/integration.json": `{"name": "integration", "uid": 2, "category": true, "extends": "base_module", "attributes": {}}`,
+		}))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(schema.CheckUIDs()).To(ConsistOf(
+			"Duplicate uid 1 in module_categories.json: core, integration",
+			"Module category 'integration' in "+filepath.Join("modules", "integration.json")+
+				" has uid 2 but module_categories.json defines uid 1",
+		))
+	})
+})
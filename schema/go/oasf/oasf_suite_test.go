@@ -0,0 +1,13 @@
+package oasf_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestOasf(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "OASF Suite")
+}
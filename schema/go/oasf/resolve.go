@@ -0,0 +1,296 @@
+package oasf
+
+import (
+	"fmt"
+	"sort"
+)
+
+// RequirementOptional is the requirement assumed for attributes that do not declare one.
+const RequirementOptional = "optional"
+
+// ResolvedAttribute is an attribute after inheritance has been flattened and
+// its dictionary definition has been merged in.
+type ResolvedAttribute struct {
+	Name        string
+	Caption     string
+	Description string
+	Requirement string
+	// Reference is the dictionary attribute backing this attribute.
+	Reference string
+	// Type is the dictionary data type, e.g. string_t. Attributes whose
+	// dictionary type names an object have type object_t and ObjectType set.
+	Type       string
+	ObjectType string
+	ClassType  string
+	Family     string
+	ValueType  string
+	IsArray    bool
+	// IsEnum is set for object attributes whose values are instances of one
+	// of the descendants of ObjectType, e.g. the deployment options of ACP.
+	IsEnum     bool
+	Sibling    string
+	Enum       map[string]*EnumValue
+	Deprecated *Deprecated
+	// Source is the name of the class or object that last declared the attribute.
+	Source string
+}
+
+// RequirementOverride records an attribute whose requirement was changed by a
+// descendant in the extends chain.
+type RequirementOverride struct {
+	Attribute string
+	// Source declared the new requirement, overriding the one from Inherited.
+	Source    string
+	Inherited string
+	From      string
+	To        string
+}
+
+// ResolvedEntity is a class or object with its whole extends chain flattened.
+type ResolvedEntity struct {
+	Name        string
+	Caption     string
+	Description string
+	Path        string
+	// Family is empty for objects.
+	Family     Family
+	Category   bool
+	Deprecated *Deprecated
+	// Chain lists the entity followed by all of its ancestors, nearest first.
+	Chain       []string
+	Attributes  map[string]*ResolvedAttribute
+	Constraints *Constraints
+	Profiles    []string
+	Overrides   []RequirementOverride
+}
+
+// ResolveClass flattens the extends chain of the named class.
+func (s *Schema) ResolveClass(family Family, name string) (*ResolvedEntity, error) {
+	classes := s.Classes(family)
+	class := classes[name]
+	if class == nil {
+		return nil, fmt.Errorf("%s class '%s' is not defined", family, name)
+	}
+	entity, err := s.resolve(&class.Object, func(name string) *Object {
+		if parent := classes[name]; parent != nil {
+			return &parent.Object
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	entity.Family = family
+	entity.Category = class.Category
+	return entity, nil
+}
+
+// ResolveObject flattens the extends chain of the named object.
+func (s *Schema) ResolveObject(name string) (*ResolvedEntity, error) {
+	object := s.Objects[name]
+	if object == nil {
+		return nil, fmt.Errorf("object '%s' is not defined", name)
+	}
+	return s.resolve(object, func(name string) *Object { return s.Objects[name] })
+}
+
+func (s *Schema) resolve(object *Object, lookup func(string) *Object) (*ResolvedEntity, error) {
+	chain := []*Object{object}
+	seen := map[string]bool{object.Name: true}
+	for current := object; current.Extends != ""; {
+		parent := lookup(current.Extends)
+		if parent == nil {
+			return nil, fmt.Errorf("'%s' in %s extends undefined '%s'", current.Name, current.Path, current.Extends)
+		}
+		if seen[parent.Name] {
+			return nil, fmt.Errorf("'%s' in %s extends '%s' which is already part of its inheritance chain", current.Name, current.Path, parent.Name)
+		}
+		seen[parent.Name] = true
+		chain = append(chain, parent)
+		current = parent
+	}
+
+	entity := &ResolvedEntity{
+		Name:        object.Name,
+		Caption:     object.Caption,
+		Description: object.Description,
+		Path:        object.Path,
+		Attributes:  make(map[string]*ResolvedAttribute),
+	}
+
+	// Merge from the root down so that descendants override their ancestors.
+	attributes := make(map[string]*Attribute)
+	sources := make(map[string]string)
+	for i := len(chain) - 1; i >= 0; i-- {
+		current := chain[i]
+		entity.Chain = append([]string{current.Name}, entity.Chain...)
+		for _, key := range sortedKeys(current.Attributes) {
+			attribute := current.Attributes[key]
+			if attribute == nil {
+				// A null attribute removes an inherited one.
+				delete(attributes, key)
+				delete(sources, key)
+				continue
+			}
+			if inherited := attributes[key]; inherited != nil && inherited.Requirement != "" &&
+				attribute.Requirement != "" && inherited.Requirement != attribute.Requirement {
+				entity.Overrides = append(entity.Overrides, RequirementOverride{
+					Attribute: key,
+					Source:    current.Name,
+					Inherited: sources[key],
+					From:      inherited.Requirement,
+					To:        attribute.Requirement,
+				})
+			}
+			attributes[key] = mergeAttribute(attributes[key], attribute)
+			sources[key] = current.Name
+		}
+		if current.Constraints != nil {
+			entity.Constraints = current.Constraints
+		}
+		if current.Deprecated != nil {
+			entity.Deprecated = current.Deprecated
+		}
+		entity.Profiles = mergeProfiles(entity.Profiles, current.Profiles)
+	}
+
+	var undefined []string
+	for _, key := range sortedKeys(attributes) {
+		attribute, err := s.resolveAttribute(key, attributes[key])
+		if err != nil {
+			undefined = append(undefined, err.Error())
+			continue
+		}
+		attribute.Source = sources[key]
+		entity.Attributes[key] = attribute
+	}
+	if len(undefined) > 0 {
+		return nil, fmt.Errorf("'%s' in %s uses attributes not defined in the dictionary: %v", object.Name, object.Path, undefined)
+	}
+	return entity, nil
+}
+
+func (s *Schema) resolveAttribute(key string, attribute *Attribute) (*ResolvedAttribute, error) {
+	reference := attribute.DictionaryName(key)
+	base := s.Dictionary.Attributes[reference]
+	if base == nil {
+		return nil, fmt.Errorf("%s", reference)
+	}
+
+	resolved := &ResolvedAttribute{
+		Name:        key,
+		Caption:     base.Caption,
+		Description: base.Description,
+		Reference:   reference,
+		Type:        base.Type,
+		ClassType:   base.ClassType,
+		Family:      base.Family,
+		ValueType:   base.ValueType,
+		IsArray:     base.IsArray,
+		IsEnum:      base.IsEnum,
+		Sibling:     base.Sibling,
+		Enum:        mergeEnum(base.Enum, attribute.Enum),
+		Deprecated:  base.Deprecated,
+		Requirement: attribute.Requirement,
+	}
+	if _, ok := s.Dictionary.Types.Attributes[base.Type]; !ok {
+		// Like the server, dictionary types that are not data types name objects.
+		resolved.Type = "object_t"
+		resolved.ObjectType = base.Type
+	}
+	if attribute.Caption != "" {
+		resolved.Caption = attribute.Caption
+	}
+	if attribute.Description != "" {
+		resolved.Description = attribute.Description
+	}
+	if attribute.Sibling != "" {
+		resolved.Sibling = attribute.Sibling
+	}
+	if attribute.Deprecated != nil {
+		resolved.Deprecated = attribute.Deprecated
+	}
+	if resolved.Requirement == "" {
+		resolved.Requirement = RequirementOptional
+	}
+	return resolved, nil
+}
+
+func mergeAttribute(base, attribute *Attribute) *Attribute {
+	if base == nil {
+		merged := *attribute
+		return &merged
+	}
+	merged := *base
+	if attribute.Caption != "" {
+		merged.Caption = attribute.Caption
+	}
+	if attribute.Description != "" {
+		merged.Description = attribute.Description
+	}
+	if attribute.Requirement != "" {
+		merged.Requirement = attribute.Requirement
+	}
+	if attribute.Reference != "" {
+		merged.Reference = attribute.Reference
+	}
+	if attribute.Group != "" {
+		merged.Group = attribute.Group
+	}
+	if attribute.Sibling != "" {
+		merged.Sibling = attribute.Sibling
+	}
+	if attribute.Source != "" {
+		merged.Source = attribute.Source
+	}
+	if attribute.References != nil {
+		merged.References = attribute.References
+	}
+	if attribute.Deprecated != nil {
+		merged.Deprecated = attribute.Deprecated
+	}
+	merged.Enum = mergeEnum(base.Enum, attribute.Enum)
+	return &merged
+}
+
+func mergeEnum(base, enum map[string]*EnumValue) map[string]*EnumValue {
+	if len(base) == 0 {
+		return enum
+	}
+	if len(enum) == 0 {
+		return base
+	}
+	merged := make(map[string]*EnumValue, len(base)+len(enum))
+	for k, v := range base {
+		merged[k] = v
+	}
+	for k, v := range enum {
+		merged[k] = v
+	}
+	return merged
+}
+
+func mergeProfiles(profiles, more []string) []string {
+	for _, p := range more {
+		found := false
+		for _, existing := range profiles {
+			if existing == p {
+				found = true
+				break
+			}
+		}
+		if !found {
+			profiles = append(profiles, p)
+		}
+	}
+	return profiles
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
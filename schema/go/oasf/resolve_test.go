@@ -0,0 +1,125 @@
+package oasf_test
+
+import (
+	"github.com/agntcy/oasf/schema/go/oasf"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Extends resolution", func() {
+	var schema *oasf.Schema
+
+	BeforeEach(func() {
+		var err error
+		schema, err = oasf.Load(schemaDir)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should resolve every class and object", func() {
+		for _, family := range oasf.Families {
+			for name := range schema.Classes(family) {
+				_, err := schema.ResolveClass(family, name)
+				Expect(err).NotTo(HaveOccurred())
+			}
+		}
+		for name := range schema.Objects {
+			_, err := schema.ResolveObject(name)
+			Expect(err).NotTo(HaveOccurred())
+		}
+	})
+
+	It("should flatten the whole module chain", func() {
+		mcp, err := schema.ResolveClass(oasf.FamilyModule, "mcp")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(mcp.Chain).To(Equal([]string{"mcp", "integration", "base_module"}))
+		Expect(mcp.Attributes).To(HaveKey("annotations"))
+		Expect(mcp.Attributes["annotations"].Source).To(Equal("base_module"))
+
+		data := mcp.Attributes["data"]
+		Expect(data.Source).To(Equal("mcp"))
+		Expect(data.Reference).To(Equal("mcp_data"))
+		Expect(data.Type).To(Equal("object_t"))
+		Expect(data.ObjectType).To(Equal("mcp_data"))
+		Expect(data.Requirement).To(Equal("required"))
+
+		artifact := mcp.Attributes["artifact"]
+		Expect(artifact.ObjectType).To(Equal("descriptor"))
+		Expect(artifact.Requirement).To(Equal("optional"))
+	})
+
+	It("should flatten object chains and resolve dictionary references", func() {
+		data, err := schema.ResolveObject("mcp_data")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(data.Chain).To(Equal([]string{"mcp_data", "module_data", "object"}))
+		Expect(data.Family).To(BeEmpty())
+
+		tools := data.Attributes["tools"]
+		Expect(tools.Reference).To(Equal("mcp_server_tools"))
+		Expect(tools.IsArray).To(BeTrue())
+		Expect(tools.ObjectType).To(Equal("mcp_server_tool"))
+	})
+
+	It("should inherit constraints but not the category flag", func() {
+		leaf, err := schema.ResolveClass(oasf.FamilySkill, "threat_detection")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(leaf.Chain).To(HaveLen(4))
+		Expect(leaf.Chain[len(leaf.Chain)-1]).To(Equal("base_skill"))
+		Expect(leaf.Category).To(BeFalse())
+		Expect(leaf.Constraints.AtLeastOne).To(ConsistOf("id", "name"))
+		Expect(leaf.Attributes["skills"]).To(BeNil())
+		Expect(leaf.Attributes["id"].Type).To(Equal("integer_t"))
+	})
+})
+
+var _ = Describe("Extends resolution on custom trees", func() {
+	dictionary := `{"attributes": {
+		"name": {"caption": "Name", "description": "Name.", "type": "string_t"},
+		"title": {"caption": "Title", "description": "Title.", "type": "string_t"},
+		"kind": {"caption": "Kind", "description": "Kind.", "type": "string_t",
+			"enum": {"a": {"caption": "A"}}}
+	}, "types": {"attributes": {"string_t": {"caption": "String", "description": "String."}}}}`
+
+	It("should record requirement overrides and honor removals", func() {
+		dir := writeSchema(map[string]string{
+			"dictionary.json": dictionary,
+			"objects/base.json": `{"name": "base", "caption": "Base", "description": "Base.",
+				"constraints": {"at_least_one": ["name", "title"]},
+				"attributes": {
+					"name": {"requirement": "optional"},
+					"title": {"requirement": "optional"},
+					"kind": {"requirement": "optional", "enum": {"b": {"caption": "B"}}}
+				}}`,
+			"objects/child.json": `{"name": "child", "extends": "base",
+				"attributes": {"name": {"requirement": "required"}, "title": null}}`,
+		})
+		schema, err := oasf.Load(dir)
+		Expect(err).NotTo(HaveOccurred())
+
+		child, err := schema.ResolveObject("child")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(child.Chain).To(Equal([]string{"child", "base"}))
+		Expect(child.Attributes).NotTo(HaveKey("title"))
+		Expect(child.Attributes["name"].Requirement).To(Equal("required"))
+		Expect(child.Attributes["kind"].Enum).To(HaveKey("a"))
+		Expect(child.Attributes["kind"].Enum).To(HaveKey("b"))
+		Expect(child.Constraints.AtLeastOne).To(ConsistOf("name", "title"))
+		Expect(child.Overrides).To(Equal([]oasf.RequirementOverride{
+			{Attribute: "name", Source: "child", Inherited: "base", From: "optional", To: "required"},
+		}))
+	})
+
+	It("should fail on undefined parents and dictionary attributes", func() {
+		dir := writeSchema(map[string]string{
+			"dictionary.json":      dictionary,
+			"objects/orphan.json":  `{"name": "orphan", "extends": "missing", "attributes": {}}`,
+			"objects/unknown.json": `{"name": "unknown", "attributes": {"nope": {}}}`,
+		})
+		schema, err := oasf.Load(dir)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = schema.ResolveObject("orphan")
+		Expect(err).To(MatchError(ContainSubstring("extends undefined 'missing'")))
+		_, err = schema.ResolveObject("unknown")
+		Expect(err).To(MatchError(ContainSubstring("not defined in the dictionary: [nope]")))
+	})
+})
@@ -0,0 +1,184 @@
+package oasf
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Schema is the typed content of an OASF schema tree.
+type Schema struct {
+	Version          string
+	Dictionary       *Dictionary
+	ModuleCategories *Categories
+	Skills           map[string]*Class
+	Domains          map[string]*Class
+	Modules          map[string]*Class
+	Objects          map[string]*Object
+	Profiles         map[string]*Profile
+}
+
+// LoadError reports every problem found while loading a schema tree.
+type LoadError struct {
+	Errors []string
+}
+
+func (e *LoadError) Error() string {
+	return "schema loading failed:\n" + strings.Join(e.Errors, "\n")
+}
+
+// Load reads the schema tree rooted at dir.
+func Load(dir string) (*Schema, error) {
+	cache, err := LoadCache(dir)
+	if err != nil {
+		return nil, err
+	}
+	return LoadFromCache(cache)
+}
+
+// LoadFromCache builds a Schema from files already read into a cache.
+// All problems are collected and returned together as a *LoadError.
+func LoadFromCache(cache *SchemaCache) (*Schema, error) {
+	l := &loader{cache: cache}
+	schema := &Schema{
+		Dictionary:       &Dictionary{},
+		ModuleCategories: &Categories{},
+		Objects:          make(map[string]*Object),
+		Profiles:         make(map[string]*Profile),
+	}
+
+	var version Version
+	l.decodeFile("version.json", &version)
+	schema.Version = version.Version
+	l.decodeFile("dictionary.json", schema.Dictionary)
+	l.decodeFile("module_categories.json", schema.ModuleCategories)
+
+	for _, family := range Families {
+		classes := make(map[string]*Class)
+		paths := make(map[string][]string)
+		for _, file := range cache.JSONFiles(family.Dir()) {
+			class := &Class{}
+			if !l.decode(file, class) {
+				continue
+			}
+			class.Path = cache.Rel(file.Path)
+			class.Family = family
+			if l.checkName(class.Name, class.Path, paths) {
+				classes[class.Name] = class
+			}
+		}
+		l.checkDuplicates(family.Dir(), paths)
+		schema.setClasses(family, classes)
+	}
+
+	paths := make(map[string][]string)
+	for _, file := range cache.JSONFiles("objects") {
+		object := &Object{}
+		if !l.decode(file, object) {
+			continue
+		}
+		object.Path = cache.Rel(file.Path)
+		if l.checkName(object.Name, object.Path, paths) {
+			schema.Objects[object.Name] = object
+		}
+	}
+	l.checkDuplicates("objects", paths)
+
+	paths = make(map[string][]string)
+	for _, file := range cache.JSONFiles("profiles") {
+		profile := &Profile{}
+		if !l.decode(file, profile) {
+			continue
+		}
+		profile.Path = cache.Rel(file.Path)
+		if l.checkName(profile.Name, profile.Path, paths) {
+			schema.Profiles[profile.Name] = profile
+		}
+	}
+	l.checkDuplicates("profiles", paths)
+
+	if len(l.errors) > 0 {
+		return nil, &LoadError{Errors: l.errors}
+	}
+	return schema, nil
+}
+
+// Classes returns the classes of the given family keyed by name.
+func (s *Schema) Classes(family Family) map[string]*Class {
+	switch family {
+	case FamilySkill:
+		return s.Skills
+	case FamilyDomain:
+		return s.Domains
+	case FamilyModule:
+		return s.Modules
+	}
+	return nil
+}
+
+// Class returns the class of the given family with the given name, or nil.
+func (s *Schema) Class(family Family, name string) *Class {
+	return s.Classes(family)[name]
+}
+
+// Object returns the object with the given name, or nil.
+func (s *Schema) Object(name string) *Object {
+	return s.Objects[name]
+}
+
+func (s *Schema) setClasses(family Family, classes map[string]*Class) {
+	switch family {
+	case FamilySkill:
+		s.Skills = classes
+	case FamilyDomain:
+		s.Domains = classes
+	case FamilyModule:
+		s.Modules = classes
+	}
+}
+
+type loader struct {
+	cache  *SchemaCache
+	errors []string
+}
+
+func (l *loader) decodeFile(name string, v any) {
+	file := l.cache.File(filepath.Join(l.cache.Root, name))
+	if file == nil {
+		l.errors = append(l.errors, fmt.Sprintf("File %s not found in %s", name, l.cache.Root))
+		return
+	}
+	l.decode(*file, v)
+}
+
+func (l *loader) decode(file SchemaFile, v any) bool {
+	if err := json.Unmarshal(file.Data, v); err != nil {
+		l.errors = append(l.errors, fmt.Sprintf("Invalid JSON in file %s: %s", l.cache.Rel(file.Path), err))
+		return false
+	}
+	return true
+}
+
+func (l *loader) checkName(name, path string, paths map[string][]string) bool {
+	if name == "" {
+		l.errors = append(l.errors, fmt.Sprintf("Missing 'name' in file %s", path))
+		return false
+	}
+	paths[name] = append(paths[name], path)
+	return len(paths[name]) == 1
+}
+
+func (l *loader) checkDuplicates(folder string, paths map[string][]string) {
+	names := make([]string, 0, len(paths))
+	for name := range paths {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if len(paths[name]) > 1 {
+			l.errors = append(l.errors, fmt.Sprintf("Duplicate name '%s' found in %s: %v", name, folder, paths[name]))
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package oasf_test
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/agntcy/oasf/schema/go/oasf"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const schemaDir = "../.."
+
+var _ = Describe("Schema loading", func() {
+	var schema *oasf.Schema
+
+	BeforeEach(func() {
+		var err error
+		schema, err = oasf.Load(schemaDir)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should load the version and dictionary", func() {
+		Expect(schema.Version).NotTo(BeEmpty())
+		Expect(schema.Dictionary.Attributes).To(HaveKey("skills"))
+		Expect(schema.Dictionary.Attributes["skills"].Family).To(Equal("skill"))
+		Expect(schema.Dictionary.Types.Attributes).To(HaveKey("port_t"))
+		Expect(schema.Dictionary.Types.Attributes["port_t"].Range).To(Equal([]float64{0, 65535}))
+	})
+
+	It("should load module categories", func() {
+		Expect(schema.ModuleCategories.Attributes).To(HaveKey("core"))
+		Expect(schema.ModuleCategories.Attributes["integration"].UID).To(Equal(2))
+	})
+
+	It("should load classes of every family", func() {
+		for _, family := range oasf.Families {
+			Expect(schema.Classes(family)).To(HaveKey(family.Base()))
+		}
+		mcp := schema.Class(oasf.FamilyModule, "mcp")
+		Expect(mcp).NotTo(BeNil())
+		Expect(mcp.Family).To(Equal(oasf.FamilyModule))
+		Expect(mcp.Extends).To(Equal("integration"))
+		Expect(mcp.UID).NotTo(BeNil())
+		Expect(mcp.Path).To(Equal(filepath.Join("modules", "integration", "mcp.json")))
+		Expect(schema.Class(oasf.FamilySkill, "base_skill").UID).To(BeNil())
+	})
+
+	It("should key objects by name rather than file name", func() {
+		Expect(schema.Objects).To(HaveKey("module_data"))
+		Expect(schema.Object("module_data").Path).To(Equal(filepath.Join("objects", "feature_data.json")))
+		Expect(schema.Object("mcp_server_resource").Constraints.JustOne).To(ConsistOf("uri", "uri_template"))
+	})
+
+	It("should load profiles", func() {
+		Expect(schema.Profiles).To(HaveKey("datetime"))
+	})
+})
+
+var _ = Describe("Schema loading errors", func() {
+	It("should report every invalid or duplicate file", func() {
+		dir := writeSchema(map[string]string{
+			"skills/a.json":        `{"name": "dup", "attributes": {}}`,
+			"skills/b.json":        `{"name": "dup", "attributes": {}}`,
+			"domains/broken.json":  `{"name": `,
+			"objects/unnamed.json": `{"attributes": {}}`,
+		})
+
+		_, err := oasf.Load(dir)
+		Expect(err).To(HaveOccurred())
+		var loadErr *oasf.LoadError
+		Expect(err).To(BeAssignableToTypeOf(loadErr))
+		loadErr = err.(*oasf.LoadError)
+		Expect(loadErr.Errors).To(HaveLen(3))
+		Expect(loadErr.Errors).To(ContainElement(ContainSubstring("Invalid JSON in file domains/broken.json")))
+		Expect(loadErr.Errors).To(ContainElement(ContainSubstring("Duplicate name 'dup' found in skills")))
+		Expect(loadErr.Errors).To(ContainElement(ContainSubstring("Missing 'name' in file objects/unnamed.json")))
+	})
+})
+
+// writeSchema creates a minimal schema tree in a temporary directory. Files
+// not given are created empty so that only the provided content matters.
+func writeSchema(files map[string]string) string {
+	dir := GinkgoT().TempDir()
+	defaults := map[string]string{
+		"version.json":           `{"version": "1.0.0"}`,
+		"dictionary.json":        `{"attributes": {}, "types": {"attributes": {}}}`,
+		"module_categories.json": `{"attributes": {}}`,
+	}
+	for path, content := range defaults {
+		if _, ok := files[path]; !ok {
+			files[path] = content
+		}
+	}
+	for path, content := range files {
+		full := filepath.Join(dir, path)
+		Expect(os.MkdirAll(filepath.Dir(full), 0o755)).To(Succeed())
+		Expect(os.WriteFile(full, []byte(content), 0o644)).To(Succeed())
+	}
+	return dir
+}
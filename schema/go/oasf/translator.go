@@ -0,0 +1,188 @@
+package oasf
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	typesv1 "github.com/agntcy/oasf/proto/go/agntcy/oasf/types/v1"
+)
+
+// Translation error codes, matching the ones reported by the server validator.
+const (
+	CodeRequiredMissing = "attribute_required_missing"
+	CodeNameUnknown     = "name_unknown"
+	CodeIDUnknown       = "id_unknown"
+	CodeIDNameMismatch  = "id_name_mismatch"
+)
+
+// ClassRef is a class together with the id and name records use to refer to it.
+type ClassRef struct {
+	Family Family
+	Class  *Class
+	// ID is the full class id, e.g. 10101 for a skill nested two levels deep.
+	ID int
+	// Name is the hierarchical class name, e.g. integration/mcp.
+	Name string
+}
+
+// TranslationError reports a class reference that cannot be resolved.
+type TranslationError struct {
+	Code    string
+	Family  Family
+	Message string
+}
+
+func (e *TranslationError) Error() string {
+	return e.Message
+}
+
+// Translator resolves skill, domain and module references by class id or by
+// name, like the server translator. It is safe for concurrent use.
+type Translator struct {
+	byName map[Family]map[string]*ClassRef
+	byID   map[Family]map[int]*ClassRef
+}
+
+// NewTranslator indexes the classes of every family of the schema.
+func NewTranslator(schema *Schema) *Translator {
+	t := &Translator{
+		byName: make(map[Family]map[string]*ClassRef),
+		byID:   make(map[Family]map[int]*ClassRef),
+	}
+	for _, family := range Families {
+		classes := schema.Classes(family)
+		ids := schema.ClassIDs(family)
+		t.byName[family] = make(map[string]*ClassRef, len(classes))
+		t.byID[family] = make(map[int]*ClassRef, len(classes))
+		for _, name := range sortedKeys(classes) {
+			ref := &ClassRef{
+				Family: family,
+				Class:  classes[name],
+				ID:     ids[name],
+				Name:   className(classes, classes[name]),
+			}
+			t.byName[family][name] = ref
+			if id, ok := ids[name]; ok && t.byID[family][id] == nil {
+				t.byID[family][id] = ref
+			}
+		}
+	}
+	return t
+}
+
+// ByName returns the class of the family with the given name. Like the server,
+// only the last path segment of a hierarchical name is significant.
+func (t *Translator) ByName(family Family, name string) *ClassRef {
+	return t.byName[family][path.Base(name)]
+}
+
+// ByID returns the class of the family with the given full class id.
+func (t *Translator) ByID(family Family, id int) *ClassRef {
+	return t.byID[family][id]
+}
+
+// Resolve returns the class referred to by a name, an id or both. An empty name
+// or a zero id counts as missing. When both are given they must refer to the
+// same class.
+func (t *Translator) Resolve(family Family, name string, id int) (*ClassRef, error) {
+	if name == "" && id == 0 {
+		return nil, &TranslationError{
+			Code:    CodeRequiredMissing,
+			Family:  family,
+			Message: `Required attribute "id or name" is missing.`,
+		}
+	}
+
+	var byID, byName *ClassRef
+	var errs []error
+	if id != 0 {
+		if byID = t.ByID(family, id); byID == nil {
+			errs = append(errs, &TranslationError{
+				Code:    CodeIDUnknown,
+				Family:  family,
+				Message: fmt.Sprintf(`Unknown "id" value; no %s class is defined for %d.`, family, id),
+			})
+		}
+	}
+	if name != "" {
+		if byName = t.ByName(family, name); byName == nil {
+			errs = append(errs, &TranslationError{
+				Code:    CodeNameUnknown,
+				Family:  family,
+				Message: fmt.Sprintf(`Unknown "name" value; no %s class is defined for %s.`, family, name),
+			})
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	switch {
+	case byID == nil:
+		return byName, nil
+	case byName == nil || byID == byName:
+		return byID, nil
+	}
+	return nil, &TranslationError{
+		Code:   CodeIDNameMismatch,
+		Family: family,
+		Message: fmt.Sprintf("ID and name refer to different classes. ID %d points to class %s, name '%s' points to class %s.",
+			id, byID.Name, name, byName.Name),
+	}
+}
+
+// TranslateSkill resolves the skill and fills in its missing name or id.
+func (t *Translator) TranslateSkill(skill *typesv1.Skill) (*ClassRef, error) {
+	ref, err := t.Resolve(FamilySkill, skill.GetName(), int(skill.GetId()))
+	if err != nil {
+		return nil, err
+	}
+	skill.Name, skill.Id = fill(ref, skill.GetName(), skill.GetId())
+	return ref, nil
+}
+
+// TranslateDomain resolves the domain and fills in its missing name or id.
+func (t *Translator) TranslateDomain(domain *typesv1.Domain) (*ClassRef, error) {
+	ref, err := t.Resolve(FamilyDomain, domain.GetName(), int(domain.GetId()))
+	if err != nil {
+		return nil, err
+	}
+	domain.Name, domain.Id = fill(ref, domain.GetName(), domain.GetId())
+	return ref, nil
+}
+
+// TranslateModule resolves the module and fills in its missing name or id.
+func (t *Translator) TranslateModule(module *typesv1.Module) (*ClassRef, error) {
+	ref, err := t.Resolve(FamilyModule, module.GetName(), int(module.GetId()))
+	if err != nil {
+		return nil, err
+	}
+	module.Name, module.Id = fill(ref, module.GetName(), module.GetId())
+	return ref, nil
+}
+
+func fill(ref *ClassRef, name string, id uint32) (string, uint32) {
+	if name == "" {
+		name = ref.Name
+	}
+	if id == 0 {
+		id = uint32(ref.ID)
+	}
+	return name, id
+}
+
+// className returns the class name prefixed by the names of its ancestors,
+// leaving out the base classes.
+func className(classes map[string]*Class, class *Class) string {
+	names := []string{strings.ReplaceAll(class.Name, "/", "_")}
+	seen := map[string]bool{class.Name: true}
+	for current := classes[class.Extends]; current != nil && !seen[current.Name]; current = classes[current.Extends] {
+		seen[current.Name] = true
+		if current.Name != current.Family.Base() {
+			names = append([]string{current.Name}, names...)
+		}
+	}
+	return strings.Join(names, "/")
+}
@@ -0,0 +1,91 @@
+package oasf_test
+
+import (
+	"errors"
+
+	typesv1 "github.com/agntcy/oasf/proto/go/agntcy/oasf/types/v1"
+	"github.com/agntcy/oasf/schema/go/oasf"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func translationCodes(err error) []string {
+	var codes []string
+	for _, err := range err.(interface{ Unwrap() []error }).Unwrap() {
+		var translationErr *oasf.TranslationError
+		Expect(errors.As(err, &translationErr)).To(BeTrue())
+		codes = append(codes, translationErr.Code)
+	}
+	return codes
+}
+
+var _ = Describe("Class translation", func() {
+	var translator *oasf.Translator
+
+	BeforeEach(func() {
+		schema, err := oasf.Load(schemaDir)
+		Expect(err).NotTo(HaveOccurred())
+		translator = oasf.NewTranslator(schema)
+	})
+
+	It("should compute hierarchical ids and names", func() {
+		ref := translator.ByName(oasf.FamilySkill, "threat_detection")
+		Expect(ref.ID).To(Equal(100701))
+		Expect(ref.Name).To(Equal("cybersecurity/security_operations/threat_detection"))
+
+		ref = translator.ByID(oasf.FamilyModule, 202)
+		Expect(ref.Class.Name).To(Equal("mcp"))
+		Expect(ref.Name).To(Equal("integration/mcp"))
+
+		Expect(translator.ByID(oasf.FamilyDomain, 101).Name).To(Equal("technology/internet_of_things"))
+	})
+
+	It("should fill in a missing id from the name", func() {
+		skill := &typesv1.Skill{Name: "language_processing/language_understanding/contextual_comprehension"}
+		ref, err := translator.TranslateSkill(skill)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ref.Class.Name).To(Equal("contextual_comprehension"))
+		Expect(skill.GetId()).To(Equal(uint32(10101)))
+	})
+
+	It("should fill in a missing name from the id", func() {
+		domain := &typesv1.Domain{Id: 101}
+		_, err := translator.TranslateDomain(domain)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(domain.GetName()).To(Equal("technology/internet_of_things"))
+
+		module := &typesv1.Module{Id: 202}
+		_, err = translator.TranslateModule(module)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(module.GetName()).To(Equal("integration/mcp"))
+	})
+
+	It("should resolve descoped names like the server", func() {
+		skill := &typesv1.Skill{Name: "contextual_comprehension", Id: 10101}
+		ref, err := translator.TranslateSkill(skill)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ref.Name).To(Equal("language_processing/language_understanding/contextual_comprehension"))
+		Expect(skill.GetName()).To(Equal("contextual_comprehension"))
+	})
+
+	It("should detect an id and name that disagree", func() {
+		skill := &typesv1.Skill{Name: "cybersecurity/security_operations/threat_detection", Id: 10101}
+		_, err := translator.TranslateSkill(skill)
+		var translationErr *oasf.TranslationError
+		Expect(errors.As(err, &translationErr)).To(BeTrue())
+		Expect(translationErr.Code).To(Equal(oasf.CodeIDNameMismatch))
+		Expect(translationErr.Error()).To(Equal("ID and name refer to different classes. " +
+			"ID 10101 points to class language_processing/language_understanding/contextual_comprehension, " +
+			"name 'cybersecurity/security_operations/threat_detection' points to class cybersecurity/security_operations/threat_detection."))
+	})
+
+	It("should report unknown ids and names", func() {
+		_, err := translator.Resolve(oasf.FamilySkill, "no_such_skill", 999999999)
+		Expect(translationCodes(err)).To(Equal([]string{oasf.CodeIDUnknown, oasf.CodeNameUnknown}))
+
+		_, err = translator.TranslateModule(&typesv1.Module{})
+		var translationErr *oasf.TranslationError
+		Expect(errors.As(err, &translationErr)).To(BeTrue())
+		Expect(translationErr.Code).To(Equal(oasf.CodeRequiredMissing))
+	})
+})
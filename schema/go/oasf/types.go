@@ -0,0 +1,176 @@
+package oasf
+
+// Family identifies one of the class families defined by the schema.
+type Family string
+
+const (
+	FamilySkill  Family = "skill"
+	FamilyDomain Family = "domain"
+	FamilyModule Family = "module"
+)
+
+// Families lists every class family in a stable order.
+var Families = []Family{FamilySkill, FamilyDomain, FamilyModule}
+
+// Dir returns the schema directory holding the classes of the family.
+func (f Family) Dir() string {
+	return string(f) + "s"
+}
+
+// Base returns the name of the base class every class of the family extends.
+func (f Family) Base() string {
+	return "base_" + string(f)
+}
+
+// Version is the content of version.json.
+type Version struct {
+	Version string `json:"version"`
+}
+
+// Deprecated marks an attribute, object or class as deprecated.
+type Deprecated struct {
+	Message string `json:"message"`
+	Since   string `json:"since"`
+}
+
+// Reference is a hyperlink to an associated definition.
+type Reference struct {
+	Description string `json:"description"`
+	URL         string `json:"url"`
+}
+
+// EnumValue is a single value of an attribute enumeration.
+type EnumValue struct {
+	Caption     string      `json:"caption"`
+	Description string      `json:"description,omitempty"`
+	Source      string      `json:"source,omitempty"`
+	References  []Reference `json:"references,omitempty"`
+	Deprecated  *Deprecated `json:"@deprecated,omitempty"`
+}
+
+// Constraints restrict which attributes must be present together.
+type Constraints struct {
+	AtLeastOne []string `json:"at_least_one,omitempty"`
+	JustOne    []string `json:"just_one,omitempty"`
+}
+
+// Attribute is an attribute as declared by a class, object or profile.
+// Missing fields are inherited from the dictionary attribute it refers to.
+type Attribute struct {
+	Caption     string                `json:"caption,omitempty"`
+	Description string                `json:"description,omitempty"`
+	Requirement string                `json:"requirement,omitempty"`
+	Reference   string                `json:"reference,omitempty"`
+	Group       string                `json:"group,omitempty"`
+	Sibling     string                `json:"sibling,omitempty"`
+	Source      string                `json:"source,omitempty"`
+	Enum        map[string]*EnumValue `json:"enum,omitempty"`
+	References  []Reference           `json:"references,omitempty"`
+	Deprecated  *Deprecated           `json:"@deprecated,omitempty"`
+}
+
+// DictionaryName returns the name of the dictionary attribute backing the attribute
+// declared under the given key.
+func (a *Attribute) DictionaryName(key string) string {
+	if a != nil && a.Reference != "" {
+		return a.Reference
+	}
+	return key
+}
+
+// Object is a schema object definition from the objects directory.
+type Object struct {
+	Path        string                `json:"-"`
+	Name        string                `json:"name"`
+	Caption     string                `json:"caption"`
+	Description string                `json:"description"`
+	Extends     string                `json:"extends,omitempty"`
+	Attributes  map[string]*Attribute `json:"attributes"`
+	Constraints *Constraints          `json:"constraints,omitempty"`
+	Profiles    []string              `json:"profiles,omitempty"`
+	References  []Reference           `json:"references,omitempty"`
+	Deprecated  *Deprecated           `json:"@deprecated,omitempty"`
+}
+
+// Class is a skill, domain or module class definition.
+type Class struct {
+	Object
+	Family       Family              `json:"-"`
+	UID          *int                `json:"uid,omitempty"`
+	Category     bool                `json:"category,omitempty"`
+	Associations map[string][]string `json:"associations,omitempty"`
+}
+
+// Profile is an overlay of attributes applicable to classes and objects.
+type Profile struct {
+	Path        string                `json:"-"`
+	Name        string                `json:"name"`
+	Caption     string                `json:"caption"`
+	Description string                `json:"description"`
+	Meta        string                `json:"meta"`
+	Extends     string                `json:"extends,omitempty"`
+	Attributes  map[string]*Attribute `json:"attributes"`
+	Annotations map[string]any        `json:"annotations,omitempty"`
+}
+
+// Category is a single entry of a categories file.
+type Category struct {
+	UID         int    `json:"uid"`
+	Caption     string `json:"caption"`
+	Description string `json:"description"`
+}
+
+// Categories is the content of a categories file such as module_categories.json.
+type Categories struct {
+	Name        string               `json:"name"`
+	Caption     string               `json:"caption"`
+	Description string               `json:"description"`
+	Attributes  map[string]*Category `json:"attributes"`
+}
+
+// DictionaryAttribute is an attribute defined in dictionary.json.
+type DictionaryAttribute struct {
+	Caption        string                `json:"caption"`
+	Description    string                `json:"description"`
+	Type           string                `json:"type"`
+	ClassType      string                `json:"class_type,omitempty"`
+	Family         string                `json:"family,omitempty"`
+	ValueType      string                `json:"value_type,omitempty"`
+	IsArray        bool                  `json:"is_array,omitempty"`
+	IsEnum         bool                  `json:"is_enum,omitempty"`
+	Sibling        string                `json:"sibling,omitempty"`
+	Source         string                `json:"source,omitempty"`
+	Enum           map[string]*EnumValue `json:"enum,omitempty"`
+	SuppressChecks []string              `json:"suppress_checks,omitempty"`
+	References     []Reference           `json:"references,omitempty"`
+	Deprecated     *Deprecated           `json:"@deprecated,omitempty"`
+}
+
+// DataType is a data type defined in the types section of dictionary.json.
+type DataType struct {
+	Caption     string      `json:"caption"`
+	Description string      `json:"description"`
+	Type        string      `json:"type,omitempty"`
+	TypeName    string      `json:"type_name,omitempty"`
+	Regex       string      `json:"regex,omitempty"`
+	MaxLen      *int        `json:"max_len,omitempty"`
+	Range       []float64   `json:"range,omitempty"`
+	Values      []any       `json:"values,omitempty"`
+	References  []Reference `json:"references,omitempty"`
+}
+
+// DataTypes is the types section of dictionary.json.
+type DataTypes struct {
+	Caption     string               `json:"caption"`
+	Description string               `json:"description"`
+	Attributes  map[string]*DataType `json:"attributes"`
+}
+
+// Dictionary is the content of dictionary.json.
+type Dictionary struct {
+	Name        string                          `json:"name"`
+	Caption     string                          `json:"caption"`
+	Description string                          `json:"description"`
+	Attributes  map[string]*DictionaryAttribute `json:"attributes"`
+	Types       DataTypes                       `json:"types"`
+}
@@ -0,0 +1,63 @@
+package oasf
+
+// ClassID returns the full id of the named class, computed like the server
+// from the uids along its extends chain: each ancestor contributes its uid as
+// two more significant decimal digits, e.g. threat_detection (1) under
+// security_operations (7) under cybersecurity (10) is 100701. A class without
+// a uid contributes 0, so its id is the id of its parent times 100, while
+// ancestors without a uid, such as the base classes, are skipped. It returns
+// false if the class does not exist.
+func (s *Schema) ClassID(family Family, name string) (int, bool) {
+	classes := s.Classes(family)
+	class := classes[name]
+	if class == nil {
+		return 0, false
+	}
+	return classID(classes, class), true
+}
+
+// ClassIDs returns the full id of every class of the family, keyed by class
+// name.
+func (s *Schema) ClassIDs(family Family) map[string]int {
+	classes := s.Classes(family)
+	ids := make(map[string]int, len(classes))
+	for name, class := range classes {
+		ids[name] = classID(classes, class)
+	}
+	return ids
+}
+
+// ClassIndex returns the classes of the family keyed by their full id. When
+// several classes share an id, the one whose name sorts first is kept.
+func (s *Schema) ClassIndex(family Family) map[int]*Class {
+	classes := s.Classes(family)
+	index := make(map[int]*Class, len(classes))
+	for _, name := range sortedKeys(classes) {
+		if id := classID(classes, classes[name]); index[id] == nil {
+			index[id] = classes[name]
+		}
+	}
+	return index
+}
+
+func classID(classes map[string]*Class, class *Class) int {
+	id := uid(class)
+	scale := 100
+	seen := map[string]bool{class.Name: true}
+	for current := classes[class.Extends]; current != nil && !seen[current.Name]; current = classes[current.Extends] {
+		seen[current.Name] = true
+		if current.UID != nil {
+			id += *current.UID * scale
+			scale *= 100
+		}
+	}
+	return id
+}
+
+// uid returns the uid of a class, 0 when it declares none like on the server.
+func uid(class *Class) int {
+	if class.UID == nil {
+		return 0
+	}
+	return *class.UID
+}
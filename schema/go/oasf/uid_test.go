@@ -0,0 +1,71 @@
+package oasf_test
+
+import (
+	"github.com/agntcy/oasf/schema/go/oasf"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Class ids", func() {
+	var schema *oasf.Schema
+
+	BeforeEach(func() {
+		var err error
+		schema, err = oasf.Load(schemaDir)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should compute full ids from the ancestry", func() {
+		ids := schema.ClassIDs(oasf.FamilySkill)
+		Expect(ids).To(HaveKeyWithValue("cybersecurity", 10))
+		Expect(ids).To(HaveKeyWithValue("security_operations", 1007))
+		Expect(ids).To(HaveKeyWithValue("threat_detection", 100701))
+		Expect(ids).To(HaveKeyWithValue("fuzzing", 100303))
+		Expect(ids).To(HaveKeyWithValue("base_skill", 0))
+
+		id, ok := schema.ClassID(oasf.FamilyModule, "mcp")
+		Expect(ok).To(BeTrue())
+		Expect(id).To(Equal(202))
+
+		id, ok = schema.ClassID(oasf.FamilyDomain, "base_domain")
+		Expect(ok).To(BeTrue())
+		Expect(id).To(BeZero())
+
+		_, ok = schema.ClassID(oasf.FamilyDomain, "no_such_domain")
+		Expect(ok).To(BeFalse())
+	})
+
+	It("should index every class by its full id", func() {
+		for _, family := range oasf.Families {
+			index := schema.ClassIndex(family)
+			for name, id := range schema.ClassIDs(family) {
+				Expect(index).To(HaveKey(id))
+				if index[id].Name != name {
+					// Colliding ids are reported by the lint checks.
+					continue
+				}
+				Expect(index[id]).To(BeIdenticalTo(schema.Class(family, name)))
+			}
+		}
+		Expect(schema.ClassIndex(oasf.FamilyDomain)[101].Name).To(Equal("internet_of_things"))
+	})
+
+	It("should skip ancestors without a uid like the server", func() {
+		dir := writeSchema(map[string]string{
+			"skills/base_skill.json": `{"name": "base_skill", "attributes": {}}`,
+			"skills/hidden.json":     `{"name": "hidden", "extends": "base_skill", "attributes": {}}`,
+			"skills/root.json":       `{"name": "root", "uid": 4, "extends": "hidden", "attributes": {}}`,
+			"skills/leaf.json":       `{"name": "leaf", "uid": 12, "extends": "root", "attributes": {}}`,
+			"skills/group.json":      `{"name": "group", "extends": "root", "attributes": {}}`,
+			"skills/member.json":     `{"name": "member", "uid": 5, "extends": "group", "attributes": {}}`,
+		})
+		custom, err := oasf.Load(dir)
+		Expect(err).NotTo(HaveOccurred())
+		// Classes without a uid count as uid 0.
+		Expect(custom.ClassIDs(oasf.FamilySkill)).To(Equal(map[string]int{
+			"base_skill": 0, "hidden": 0, "root": 4, "leaf": 412, "group": 400, "member": 405,
+		}))
+		Expect(custom.ClassIndex(oasf.FamilySkill)[412].Name).To(Equal("leaf"))
+		Expect(custom.ClassIndex(oasf.FamilySkill)[400].Name).To(Equal("group"))
+	})
+})
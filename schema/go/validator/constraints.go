@@ -0,0 +1,50 @@
+package validator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/agntcy/oasf/schema/go/oasf"
+)
+
+// validateConstraints evaluates the at_least_one and just_one constraints
+// declared by an entity, or inherited through its extends chain.
+func validateConstraints(result *Result, entity *oasf.ResolvedEntity, data map[string]any, pointer string) {
+	constraints := entity.Constraints
+	if constraints == nil {
+		return
+	}
+	if keys := constraints.AtLeastOne; len(keys) > 0 {
+		if count := countPresent(data, keys); count == 0 {
+			result.addError("constraint_failed", pointer,
+				"Constraint failed: %s; expected at least one of %s, but got none.",
+				describeConstraint("at_least_one", entity, pointer), strings.Join(keys, ", "))
+		}
+	}
+	if keys := constraints.JustOne; len(keys) > 0 {
+		if count := countPresent(data, keys); count != 1 {
+			result.addError("constraint_failed", pointer,
+				"Constraint failed: %s; expected exactly 1 of %s, got %d.",
+				describeConstraint("just_one", entity, pointer), strings.Join(keys, ", "), count)
+		}
+	}
+}
+
+func describeConstraint(constraint string, entity *oasf.ResolvedEntity, pointer string) string {
+	if pointer == "" {
+		return fmt.Sprintf("%q from %s", constraint, describe(entity))
+	}
+	return fmt.Sprintf("%q from %s at %q", constraint, describe(entity), pointer)
+}
+
+// countPresent counts the keys with a value. Like for requirements, null
+// values count as missing.
+func countPresent(data map[string]any, keys []string) int {
+	count := 0
+	for _, key := range keys {
+		if data[key] != nil {
+			count++
+		}
+	}
+	return count
+}
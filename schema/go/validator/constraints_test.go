@@ -0,0 +1,62 @@
+package validator_test
+
+import (
+	"github.com/agntcy/oasf/schema/go/oasf"
+	"github.com/agntcy/oasf/schema/go/validator"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Constraint validation", func() {
+	var v *validator.Validator
+
+	BeforeEach(func() {
+		schema, err := oasf.Load(schemaDir)
+		Expect(err).NotTo(HaveOccurred())
+		v = validator.New(schema)
+	})
+
+	It("should enforce just_one on nested objects", func() {
+		record := loadRecord()
+		data := record["modules"].([]any)[0].(map[string]any)["data"].(map[string]any)
+		data["resources"] = []any{
+			map[string]any{"uri": "file:///a", "name": "a", "audience": []any{"user"}},
+			map[string]any{"uri": "file:///b", "uri_template": "file:///{id}", "name": "b", "audience": []any{"user"}},
+			map[string]any{"name": "c", "audience": []any{"user"}},
+			map[string]any{"uri": nil, "uri_template": "file:///{id}", "name": "d", "audience": []any{"user"}},
+		}
+		result := v.ValidateObject("mcp_data", data)
+		Expect(result.Errors).To(ConsistOf(
+			validator.Issue{Code: "constraint_failed", Pointer: "/resources/1",
+				Message: `Constraint failed: "just_one" from object "mcp_server_resource" at "/resources/1"; expected exactly 1 of uri, uri_template, got 2.`},
+			validator.Issue{Code: "constraint_failed", Pointer: "/resources/2",
+				Message: `Constraint failed: "just_one" from object "mcp_server_resource" at "/resources/2"; expected exactly 1 of uri, uri_template, got 0.`},
+		))
+	})
+
+	It("should enforce at_least_one and just_one on descriptors", func() {
+		result := v.ValidateObject("descriptor", map[string]any{
+			"media_type": "application/json",
+			"size":       2,
+			"digest":     "sha256:abc",
+		})
+		Expect(result.Errors).To(ConsistOf(
+			validator.Issue{Code: "constraint_failed", Pointer: "",
+				Message: `Constraint failed: "at_least_one" from object "descriptor"; expected at least one of urls, data, json, but got none.`},
+			validator.Issue{Code: "constraint_failed", Pointer: "",
+				Message: `Constraint failed: "just_one" from object "descriptor"; expected exactly 1 of data, json, got 0.`},
+		))
+	})
+
+	It("should enforce constraints inherited from base classes", func() {
+		record := loadRecord()
+		record["skills"] = []any{map[string]any{"annotations": map[string]any{}}}
+
+		result := v.Validate(record)
+		Expect(result.Errors).To(ConsistOf(validator.Issue{
+			Code:    "constraint_failed",
+			Pointer: "/skills/0",
+			Message: `Constraint failed: "at_least_one" from skill class "base_skill" at "/skills/0"; expected at least one of id, name, but got none.`,
+		}))
+	})
+})
@@ -0,0 +1,79 @@
+package validator_test
+
+import (
+	"github.com/agntcy/oasf/schema/go/oasf"
+	"github.com/agntcy/oasf/schema/go/validator"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Requirement validation", func() {
+	var schema *oasf.Schema
+
+	BeforeEach(func() {
+		var err error
+		schema, err = oasf.Load(schemaDir)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should not warn about a complete record", func() {
+		result := validator.New(schema).Validate(loadRecord())
+		Expect(result.Errors).To(BeEmpty())
+		Expect(result.Warnings).To(BeEmpty())
+	})
+
+	It("should report missing required attributes as errors", func() {
+		record := loadRecord()
+		delete(record, "name")
+		record["authors"] = nil
+		record["skills"] = []any{map[string]any{"name": "language_processing/language_understanding/contextual_comprehension"}}
+
+		result := validator.New(schema).Validate(record)
+		Expect(result.Errors).To(ConsistOf(
+			validator.Issue{Code: "attribute_required_missing", Pointer: "/authors",
+				Message: `Required attribute "/authors" is missing.`},
+			validator.Issue{Code: "attribute_required_missing", Pointer: "/name",
+				Message: `Required attribute "/name" is missing.`},
+		))
+		Expect(result.Warnings).To(ConsistOf(
+			validator.Issue{Code: "attribute_recommended_missing", Pointer: "/skills/0/id",
+				Message: `Recommended attribute "/skills/0/id" is missing.`},
+		))
+	})
+
+	It("should report missing recommended attributes as warnings", func() {
+		record := loadRecord()
+		delete(record, "domains")
+		delete(record, "modules")
+		delete(record, "locators")
+
+		result := validator.New(schema).Validate(record)
+		Expect(result.Valid()).To(BeTrue())
+		Expect(codes(result.Warnings)).To(ConsistOf("attribute_recommended_missing", "attribute_recommended_missing"))
+		Expect(result.Warnings[0].Pointer).To(Equal("/domains"))
+		Expect(result.Warnings[1].Pointer).To(Equal("/modules"))
+	})
+
+	It("should not require the alternatives of a just_one constraint", func() {
+		result := validator.New(schema).ValidateObject("mcp_server_resource", map[string]any{
+			"uri_template": "file:///{id}",
+			"name":         "files",
+			"audience":     []any{"user"},
+		})
+		Expect(result.Errors).To(BeEmpty())
+	})
+
+	It("should promote warnings to errors in strict mode", func() {
+		record := loadRecord()
+		delete(record, "modules")
+
+		result := validator.New(schema, validator.Strict()).Validate(record)
+		Expect(result.Valid()).To(BeFalse())
+		Expect(result.Warnings).To(BeEmpty())
+		Expect(result.Errors).To(ConsistOf(validator.Issue{
+			Code:    "attribute_recommended_missing",
+			Pointer: "/modules",
+			Message: `Recommended attribute "/modules" is missing.`,
+		}))
+	})
+})
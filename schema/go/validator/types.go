@@ -0,0 +1,213 @@
+package validator
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+	"regexp"
+	"unicode/utf8"
+
+	"github.com/agntcy/oasf/schema/go/oasf"
+)
+
+// validateType checks a scalar value against a dictionary data type and the
+// regex, max_len, range and values rules declared along its super type chain.
+func (v *Validator) validateType(result *Result, typeName string, value any, pointer string) {
+	chain := v.typeChain(typeName)
+	if len(chain) == 0 {
+		result.addError("schema_bug_type_missing", pointer,
+			"SCHEMA BUG: Type %q is not defined in dictionary.", typeName)
+		return
+	}
+
+	switch primitive := chain[len(chain)-1].name; primitive {
+	case "string_t", "bytestring_t":
+		s, ok := value.(string)
+		if !ok {
+			addWrongType(result, pointer, value, typeName)
+			return
+		}
+		v.validateMaxLen(result, chain, s, pointer)
+		v.validateRegex(result, chain, s, pointer)
+	case "integer_t", "long_t":
+		n, ok := integer(value)
+		if !ok {
+			addWrongType(result, pointer, value, typeName)
+			return
+		}
+		validateRange(result, chain, float64(n), pointer)
+	case "float_t":
+		n, ok := number(value)
+		if !ok {
+			addWrongType(result, pointer, value, typeName)
+			return
+		}
+		validateRange(result, chain, n, pointer)
+	case "boolean_t":
+		if _, ok := value.(bool); !ok {
+			addWrongType(result, pointer, value, typeName)
+			return
+		}
+	case "json_t":
+		return
+	default:
+		if _, ok := value.(map[string]any); !ok {
+			addWrongType(result, pointer, value, typeName)
+			return
+		}
+	}
+	validateValues(result, chain, value, pointer)
+}
+
+type namedType struct {
+	name string
+	*oasf.DataType
+}
+
+// typeChain returns the data type followed by its super types.
+func (v *Validator) typeChain(typeName string) []namedType {
+	var chain []namedType
+	seen := make(map[string]bool)
+	for name := typeName; name != "" && !seen[name]; {
+		dataType := v.schema.Dictionary.Types.Attributes[name]
+		if dataType == nil {
+			return nil
+		}
+		seen[name] = true
+		chain = append(chain, namedType{name: name, DataType: dataType})
+		name = dataType.Type
+	}
+	return chain
+}
+
+// superType describes where a rule comes from when it is inherited.
+func superType(chain []namedType, definer namedType) string {
+	if definer.name == chain[0].name {
+		return ""
+	}
+	return "super type " + definer.name + " of "
+}
+
+func (v *Validator) validateMaxLen(result *Result, chain []namedType, value, pointer string) {
+	for _, t := range chain {
+		if t.MaxLen == nil {
+			continue
+		}
+		if length := utf8.RuneCountInString(value); length > *t.MaxLen {
+			result.addError("attribute_value_exceeds_max_len", pointer,
+				"Attribute %q value length of %d exceeds %stype %q max length %d.",
+				pointer, length, superType(chain, t), chain[0].name, *t.MaxLen)
+		}
+		return
+	}
+}
+
+func (v *Validator) validateRegex(result *Result, chain []namedType, value, pointer string) {
+	for _, t := range chain {
+		if t.Regex == "" {
+			continue
+		}
+		re, err := v.regex(t.Regex)
+		if err != nil {
+			result.addError("schema_bug_type_regex_invalid", pointer,
+				"SCHEMA BUG: Type %q specifies an invalid regex: %s.", t.name, err)
+			return
+		}
+		if !re.MatchString(value) {
+			result.addError("attribute_value_regex_not_matched", pointer,
+				"Attribute %q value does not match regex of %stype %q.", pointer, superType(chain, t), chain[0].name)
+		}
+		return
+	}
+}
+
+func validateRange(result *Result, chain []namedType, value float64, pointer string) {
+	for _, t := range chain {
+		if len(t.Range) != 2 {
+			continue
+		}
+		if low, high := t.Range[0], t.Range[1]; value < low || value > high {
+			result.addError("attribute_value_exceeds_range", pointer,
+				"Attribute %q value is outside %stype %q range of %v to %v.",
+				pointer, superType(chain, t), chain[0].name, low, high)
+		}
+		return
+	}
+}
+
+func validateValues(result *Result, chain []namedType, value any, pointer string) {
+	for _, t := range chain {
+		if len(t.Values) == 0 {
+			continue
+		}
+		for _, allowed := range t.Values {
+			if reflect.DeepEqual(allowed, value) {
+				return
+			}
+		}
+		result.addError("attribute_value_not_allowed", pointer,
+			"Attribute %q value is not one of the values allowed by type %q.", pointer, chain[0].name)
+		return
+	}
+}
+
+func (v *Validator) regex(expr string) (*regexp.Regexp, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if re, ok := v.regexes[expr]; ok {
+		return re, nil
+	}
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, err
+	}
+	v.regexes[expr] = re
+	return re, nil
+}
+
+// integer returns the value as an integer if it is an integral JSON number.
+func integer(value any) (int64, bool) {
+	switch n := value.(type) {
+	case json.Number:
+		i, err := n.Int64()
+		return i, err == nil
+	case float64:
+		if n != math.Trunc(n) || n < math.MinInt64 || n >= math.MaxInt64 {
+			return 0, false
+		}
+		return int64(n), true
+	case float32:
+		return integer(float64(n))
+	case int:
+		return int64(n), true
+	case int32:
+		return int64(n), true
+	case int64:
+		return n, true
+	case uint32:
+		return int64(n), true
+	case uint64:
+		if n > math.MaxInt64 {
+			return 0, false
+		}
+		return int64(n), true
+	}
+	return 0, false
+}
+
+// number returns the value as a float if it is a JSON number.
+func number(value any) (float64, bool) {
+	switch n := value.(type) {
+	case json.Number:
+		f, err := n.Float64()
+		return f, err == nil
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	}
+	if i, ok := integer(value); ok {
+		return float64(i), true
+	}
+	return 0, false
+}
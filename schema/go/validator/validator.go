@@ -0,0 +1,399 @@
+package validator
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/agntcy/oasf/schema/go/oasf"
+)
+
+// Issue is a single validation error or warning. Pointer locates the offending
+// value in the validated document as a JSON pointer (RFC 6901).
+type Issue struct {
+	Code    string `json:"code"`
+	Pointer string `json:"pointer"`
+	Message string `json:"message"`
+}
+
+func (i Issue) String() string {
+	return i.Message
+}
+
+// Result collects the issues found while validating a document.
+type Result struct {
+	Errors   []Issue `json:"errors"`
+	Warnings []Issue `json:"warnings"`
+}
+
+// Valid reports whether no errors were found.
+func (r *Result) Valid() bool {
+	return len(r.Errors) == 0
+}
+
+func (r *Result) addError(code, pointer, format string, args ...any) {
+	r.Errors = append(r.Errors, Issue{Code: code, Pointer: pointer, Message: fmt.Sprintf(format, args...)})
+}
+
+func (r *Result) addWarning(code, pointer, format string, args ...any) {
+	r.Warnings = append(r.Warnings, Issue{Code: code, Pointer: pointer, Message: fmt.Sprintf(format, args...)})
+}
+
+// Option configures a Validator.
+type Option func(*Validator)
+
+// Strict promotes every warning to an error.
+func Strict() Option {
+	return func(v *Validator) {
+		v.strict = true
+	}
+}
+
+// Validator checks documents against a loaded schema.
+// It is safe for concurrent use.
+type Validator struct {
+	schema     *oasf.Schema
+	translator *oasf.Translator
+	strict     bool
+
+	mu       sync.Mutex
+	resolved map[string]*oasf.ResolvedEntity
+	regexes  map[string]*regexp.Regexp
+}
+
+// New returns a validator for the given schema.
+func New(schema *oasf.Schema, opts ...Option) *Validator {
+	v := &Validator{
+		schema:     schema,
+		translator: oasf.NewTranslator(schema),
+		resolved:   make(map[string]*oasf.ResolvedEntity),
+		regexes:    make(map[string]*regexp.Regexp),
+	}
+	for _, opt := range opts {
+		opt(v)
+	}
+	return v
+}
+
+// Validate validates a decoded record.
+func (v *Validator) Validate(record map[string]any) *Result {
+	return v.ValidateObject("record", record)
+}
+
+// ValidateJSON decodes and validates a JSON encoded record.
+func (v *Validator) ValidateJSON(data []byte) (*Result, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var record map[string]any
+	if err := decoder.Decode(&record); err != nil {
+		return nil, fmt.Errorf("failed to decode record: %w", err)
+	}
+	return v.Validate(record), nil
+}
+
+// ValidateObject validates a decoded instance of the named schema object.
+func (v *Validator) ValidateObject(name string, data map[string]any) *Result {
+	result := &Result{}
+	entity, err := v.object(name)
+	if err != nil {
+		result.addError("schema_bug", "", "SCHEMA BUG: %s.", err)
+		return result
+	}
+	v.validateEntity(result, entity, data, "")
+	if v.strict {
+		result.Errors = append(result.Errors, result.Warnings...)
+		result.Warnings = nil
+	}
+	return result
+}
+
+func (v *Validator) validateEntity(result *Result, entity *oasf.ResolvedEntity, data map[string]any, pointer string) {
+	for _, key := range sortedKeys(data) {
+		attributePointer := pointer + "/" + escapePointer(key)
+		attribute := entity.Attributes[key]
+		if attribute == nil {
+			result.addError("attribute_unknown", attributePointer,
+				"Unknown attribute at %q; attribute %q is not defined in %s.", attributePointer, key, describe(entity))
+			continue
+		}
+		v.validateAttribute(result, attribute, data[key], attributePointer)
+	}
+	validateRequirements(result, entity, data, pointer)
+	validateConstraints(result, entity, data, pointer)
+}
+
+// validateRequirements reports missing required attributes as errors and
+// missing recommended attributes as warnings. Attributes of a just_one
+// constraint are alternatives, so their presence is left to the constraint.
+func validateRequirements(result *Result, entity *oasf.ResolvedEntity, data map[string]any, pointer string) {
+	var alternatives []string
+	if entity.Constraints != nil {
+		alternatives = entity.Constraints.JustOne
+	}
+	for _, key := range sortedAttributes(entity.Attributes) {
+		if value, ok := data[key]; ok && value != nil {
+			continue
+		}
+		if slices.Contains(alternatives, key) {
+			continue
+		}
+		attributePointer := pointer + "/" + escapePointer(key)
+		switch entity.Attributes[key].Requirement {
+		case "required":
+			result.addError("attribute_required_missing", attributePointer,
+				"Required attribute %q is missing.", attributePointer)
+		case "recommended":
+			result.addWarning("attribute_recommended_missing", attributePointer,
+				"Recommended attribute %q is missing.", attributePointer)
+		}
+	}
+}
+
+func (v *Validator) validateAttribute(result *Result, attribute *oasf.ResolvedAttribute, value any, pointer string) {
+	if value == nil {
+		return
+	}
+	if !attribute.IsArray {
+		v.validateValue(result, attribute, value, pointer)
+		return
+	}
+	items, ok := value.([]any)
+	if !ok {
+		addWrongType(result, pointer, value, "array of "+attributeType(attribute))
+		return
+	}
+	for i, item := range items {
+		v.validateValue(result, attribute, item, pointer+"/"+strconv.Itoa(i))
+	}
+}
+
+func (v *Validator) validateValue(result *Result, attribute *oasf.ResolvedAttribute, value any, pointer string) {
+	switch attribute.Type {
+	case "object_t":
+		data, ok := value.(map[string]any)
+		if !ok {
+			addWrongType(result, pointer, value, attribute.ObjectType)
+			return
+		}
+		entity, err := v.object(attribute.ObjectType)
+		if err == nil && attribute.IsEnum {
+			entity, err = v.enumObject(result, attribute, data, pointer)
+		}
+		if err != nil {
+			result.addError("schema_bug", pointer, "SCHEMA BUG: %s.", err)
+			return
+		}
+		if entity != nil {
+			v.validateEntity(result, entity, data, pointer)
+		}
+	case "class_t":
+		data, ok := value.(map[string]any)
+		if !ok {
+			addWrongType(result, pointer, value, attribute.ClassType)
+			return
+		}
+		entity, err := v.classOf(result, attribute, data, pointer)
+		if err != nil {
+			result.addError("schema_bug", pointer, "SCHEMA BUG: %s.", err)
+			return
+		}
+		v.validateEntity(result, entity, data, pointer)
+	case "typed_map_t":
+		data, ok := value.(map[string]any)
+		if !ok {
+			addWrongType(result, pointer, value, attribute.Type)
+			return
+		}
+		if attribute.ValueType == "" {
+			return
+		}
+		for _, key := range sortedKeys(data) {
+			v.validateType(result, attribute.ValueType, data[key], pointer+"/"+escapePointer(key))
+		}
+	default:
+		v.validateType(result, attribute.Type, value, pointer)
+	}
+}
+
+// classOf resolves the class a class_t value refers to by its id or name.
+// Values that do not identify a class are checked against the base class of
+// the attribute, which reports the offending id or name.
+func (v *Validator) classOf(result *Result, attribute *oasf.ResolvedAttribute, data map[string]any, pointer string) (*oasf.ResolvedEntity, error) {
+	family := oasf.Family(attribute.Family)
+	name, isName := data["name"].(string)
+	id, isID := integer(data["id"])
+	if (data["name"] != nil && !isName) || (data["id"] != nil && !isID) || (!isName && !isID) {
+		return v.class(family, attribute.ClassType)
+	}
+	ref, err := v.translator.Resolve(family, name, int(id))
+	if err != nil {
+		for _, err := range unwrap(err) {
+			var translationErr *oasf.TranslationError
+			if !errors.As(err, &translationErr) {
+				continue
+			}
+			switch translationErr.Code {
+			case oasf.CodeIDUnknown:
+				result.addError(translationErr.Code, pointer+"/id", "%s", translationErr.Message)
+			case oasf.CodeNameUnknown:
+				result.addError(translationErr.Code, pointer+"/name", "%s", translationErr.Message)
+			default:
+				result.addError(translationErr.Code, pointer, "%s", translationErr.Message)
+			}
+		}
+		return v.class(family, attribute.ClassType)
+	}
+	return v.class(family, ref.Class.Name)
+}
+
+// enumObject resolves the descendant of the object type of an is_enum
+// attribute a value is an instance of: the first one, by name, whose required
+// attributes are present, that defines every attribute of the value and whose
+// enums accept its values. Values matching no descendant are reported and nil
+// is returned.
+func (v *Validator) enumObject(result *Result, attribute *oasf.ResolvedAttribute, data map[string]any, pointer string) (*oasf.ResolvedEntity, error) {
+	var names []string
+	for _, name := range slices.Sorted(maps.Keys(v.schema.Objects)) {
+		entity, err := v.object(name)
+		if err != nil {
+			return nil, err
+		}
+		if name == attribute.ObjectType || !slices.Contains(entity.Chain, attribute.ObjectType) {
+			continue
+		}
+		names = append(names, name)
+		if matchesObject(entity, data) {
+			return entity, nil
+		}
+	}
+	result.addError("enum_object_not_matched", pointer,
+		"The object provided for attribute %q does not match any of allowed objects (%s).", pointer, strings.Join(names, ", "))
+	return nil, nil
+}
+
+func matchesObject(entity *oasf.ResolvedEntity, data map[string]any) bool {
+	for key, attribute := range entity.Attributes {
+		if _, ok := data[key]; !ok && attribute.Requirement == "required" {
+			return false
+		}
+	}
+	for key, value := range data {
+		attribute := entity.Attributes[key]
+		if attribute == nil {
+			return false
+		}
+		if attribute.Enum != nil && attribute.Enum[fmt.Sprint(value)] == nil {
+			return false
+		}
+	}
+	return true
+}
+
+func (v *Validator) object(name string) (*oasf.ResolvedEntity, error) {
+	return v.cached("object:"+name, func() (*oasf.ResolvedEntity, error) {
+		return v.schema.ResolveObject(name)
+	})
+}
+
+func (v *Validator) class(family oasf.Family, name string) (*oasf.ResolvedEntity, error) {
+	return v.cached(string(family)+":"+name, func() (*oasf.ResolvedEntity, error) {
+		return v.schema.ResolveClass(family, name)
+	})
+}
+
+func (v *Validator) cached(key string, resolve func() (*oasf.ResolvedEntity, error)) (*oasf.ResolvedEntity, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if entity, ok := v.resolved[key]; ok {
+		return entity, nil
+	}
+	entity, err := resolve()
+	if err != nil {
+		return nil, err
+	}
+	v.resolved[key] = entity
+	return entity, nil
+}
+
+func describe(entity *oasf.ResolvedEntity) string {
+	if entity.Family != "" {
+		return fmt.Sprintf("%s class %q", entity.Family, entity.Name)
+	}
+	return fmt.Sprintf("object %q", entity.Name)
+}
+
+func attributeType(attribute *oasf.ResolvedAttribute) string {
+	switch attribute.Type {
+	case "object_t":
+		return attribute.ObjectType
+	case "class_t":
+		return attribute.ClassType
+	}
+	return attribute.Type
+}
+
+func addWrongType(result *Result, pointer string, value any, expected string) {
+	result.addError("attribute_wrong_type", pointer,
+		"Attribute %q value has wrong type; expected %s, got %s.", pointer, expected, typeOf(value))
+}
+
+func typeOf(value any) string {
+	switch value := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean_t"
+	case string:
+		return "string_t"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	default:
+		if _, ok := integer(value); ok {
+			return "integer_t"
+		}
+		if _, ok := number(value); ok {
+			return "float_t"
+		}
+	}
+	return fmt.Sprintf("%T", value)
+}
+
+func unwrap(err error) []error {
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		return joined.Unwrap()
+	}
+	return []error{err}
+}
+
+// escapePointer escapes a reference token as required by RFC 6901.
+func escapePointer(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
+
+func sortedAttributes(m map[string]*oasf.ResolvedAttribute) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
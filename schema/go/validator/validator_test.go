@@ -0,0 +1,172 @@
+package validator_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/agntcy/oasf/schema/go/oasf"
+	"github.com/agntcy/oasf/schema/go/validator"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const schemaDir = "../.."
+
+func loadRecord() map[string]any {
+	data, err := os.ReadFile(filepath.Join("testdata", "record.json"))
+	Expect(err).NotTo(HaveOccurred())
+	var record map[string]any
+	Expect(json.Unmarshal(data, &record)).To(Succeed())
+	return record
+}
+
+func codes(issues []validator.Issue) []string {
+	var codes []string
+	for _, issue := range issues {
+		codes = append(codes, issue.Code)
+	}
+	return codes
+}
+
+var _ = Describe("Record validation", func() {
+	var v *validator.Validator
+
+	BeforeEach(func() {
+		schema, err := oasf.Load(schemaDir)
+		Expect(err).NotTo(HaveOccurred())
+		v = validator.New(schema)
+	})
+
+	It("should accept a valid record", func() {
+		result := v.Validate(loadRecord())
+		Expect(result.Errors).To(BeEmpty())
+		Expect(result.Valid()).To(BeTrue())
+	})
+
+	It("should accept a valid JSON encoded record", func() {
+		data, err := os.ReadFile(filepath.Join("testdata", "record.json"))
+		Expect(err).NotTo(HaveOccurred())
+		result, err := v.ValidateJSON(data)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Errors).To(BeEmpty())
+
+		_, err = v.ValidateJSON([]byte(`{"name": `))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should report wrong types with JSON pointers", func() {
+		record := loadRecord()
+		record["authors"] = "Jane Doe"
+		record["skills"].([]any)[0].(map[string]any)["id"] = "10101"
+		record["annotations"] = map[string]any{"a/b": 1}
+
+		result := v.Validate(record)
+		Expect(result.Errors).To(ConsistOf(
+			validator.Issue{Code: "attribute_wrong_type", Pointer: "/annotations/a~1b",
+				Message: `Attribute "/annotations/a~1b" value has wrong type; expected string_t, got integer_t.`},
+			validator.Issue{Code: "attribute_wrong_type", Pointer: "/authors",
+				Message: `Attribute "/authors" value has wrong type; expected array of string_t, got string_t.`},
+			validator.Issue{Code: "attribute_wrong_type", Pointer: "/skills/0/id",
+				Message: `Attribute "/skills/0/id" value has wrong type; expected integer_t, got string_t.`},
+		))
+	})
+
+	It("should enforce regex rules of the dictionary types", func() {
+		record := loadRecord()
+		record["created_at"] = "yesterday"
+
+		result := v.Validate(record)
+		Expect(codes(result.Errors)).To(Equal([]string{"attribute_value_regex_not_matched"}))
+		Expect(result.Errors[0].Pointer).To(Equal("/created_at"))
+		Expect(result.Errors[0].Message).To(ContainSubstring(`type "datetime_t"`))
+	})
+
+	It("should enforce max_len rules including those of super types", func() {
+		result := v.ValidateObject("descriptor", map[string]any{
+			"media_type":    "application/json",
+			"artifact_type": "application/" + string(make([]byte, 2000)),
+			"size":          1,
+			"digest":        "sha256:abc",
+			"json":          map[string]any{},
+		})
+		Expect(codes(result.Errors)).To(ContainElement("attribute_value_exceeds_max_len"))
+		Expect(result.Errors[0].Message).To(ContainSubstring(`super type string_t of type "mime_t" max length 2000`))
+	})
+
+	It("should enforce range rules of the dictionary types", func() {
+		result := v.ValidateObject("mcp_server_resource", map[string]any{
+			"uri":      "file:///tmp/data",
+			"name":     "data",
+			"audience": []any{"user"},
+			"priority": 1.5,
+		})
+		Expect(result.Errors).To(ConsistOf(validator.Issue{
+			Code:    "attribute_value_exceeds_range",
+			Pointer: "/priority",
+			Message: `Attribute "/priority" value is outside type "unit_interval_t" range of 0 to 1.`,
+		}))
+	})
+
+	It("should reject attributes that are not defined", func() {
+		record := loadRecord()
+		record["locators"].([]any)[0].(map[string]any)["url"] = "https://example.com"
+
+		result := v.Validate(record)
+		Expect(codes(result.Errors)).To(Equal([]string{"attribute_unknown"}))
+		Expect(result.Errors[0].Pointer).To(Equal("/locators/0/url"))
+	})
+
+	It("should report class ids and names that disagree", func() {
+		record := loadRecord()
+		record["skills"].([]any)[0].(map[string]any)["id"] = 100701
+		record["domains"].([]any)[0].(map[string]any)["name"] = "no_such_domain"
+
+		result := v.Validate(record)
+		Expect(result.Errors).To(ConsistOf(
+			validator.Issue{Code: "id_name_mismatch", Pointer: "/skills/0",
+				Message: "ID and name refer to different classes. " +
+					"ID 100701 points to class cybersecurity/security_operations/threat_detection, " +
+					"name 'language_processing/language_understanding/contextual_comprehension' points to class " +
+					"language_processing/language_understanding/contextual_comprehension."},
+			validator.Issue{Code: "name_unknown", Pointer: "/domains/0/name",
+				Message: `Unknown "name" value; no domain class is defined for no_such_domain.`},
+		))
+	})
+
+	It("should validate module data against the resolved module class", func() {
+		record := loadRecord()
+		data := record["modules"].([]any)[0].(map[string]any)["data"].(map[string]any)
+		data["connections"] = []any{map[string]any{"type": "stdio", "command": "server", "commands": "server"}}
+
+		result := v.Validate(record)
+		Expect(codes(result.Errors)).To(Equal([]string{"attribute_unknown"}))
+		Expect(result.Errors[0].Pointer).To(Equal("/modules/0/data/connections/0/commands"))
+	})
+
+	It("should validate is_enum objects against the descendant they match", func() {
+		deployment := func(option map[string]any) map[string]any {
+			return map[string]any{"deployment_options": []any{option}}
+		}
+		result := v.ValidateObject("acp_deployment", deployment(map[string]any{
+			"type":             "source_code",
+			"url":              "https://github.com/agntcy/mailcomposer",
+			"framework_config": map[string]any{"framework_type": "langgraph", "graph": "mailcomposer:graph"},
+		}))
+		Expect(result.Errors).To(BeEmpty())
+
+		result = v.ValidateObject("acp_deployment", deployment(map[string]any{
+			"type":             "source_code",
+			"url":              "https://github.com/agntcy/mailcomposer",
+			"framework_config": map[string]any{"framework_type": "crewai", "graph": "mailcomposer:graph"},
+		}))
+		Expect(result.Errors).To(ConsistOf(validator.Issue{
+			Code:    "enum_object_not_matched",
+			Pointer: "/deployment_options/0/framework_config",
+			Message: `The object provided for attribute "/deployment_options/0/framework_config" does not match any of allowed objects (langgraph_config, llamaindex_config).`,
+		}))
+
+		result = v.ValidateObject("acp_deployment", deployment(map[string]any{"type": "docker"}))
+		Expect(codes(result.Errors)).To(Equal([]string{"enum_object_not_matched"}))
+	})
+})
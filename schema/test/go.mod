@@ -3,6 +3,7 @@ module schema
 go 1.24.5
 
 require (
+	github.com/agntcy/oasf/schema/go v0.0.0-00010101000000-000000000000
 	github.com/onsi/ginkgo/v2 v2.25.3
 	github.com/onsi/gomega v1.38.2
 	github.com/xeipuuv/gojsonschema v1.2.0
@@ -10,6 +11,7 @@ require (
 
 require (
 	github.com/Masterminds/semver/v3 v3.4.0 // indirect
+	github.com/agntcy/oasf/proto/go v0.0.0-00010101000000-000000000000 // indirect
 	github.com/go-logr/logr v1.4.3 // indirect
 	github.com/go-task/slim-sprig/v3 v3.0.0 // indirect
 	github.com/google/go-cmp v0.7.0 // indirect
@@ -22,4 +24,10 @@ require (
 	golang.org/x/sys v0.35.0 // indirect
 	golang.org/x/text v0.28.0 // indirect
 	golang.org/x/tools v0.36.0 // indirect
+	google.golang.org/protobuf v1.36.12 // indirect
+)
+
+replace (
+	github.com/agntcy/oasf/proto/go => ../../proto/go
+	github.com/agntcy/oasf/schema/go => ../go
 )
@@ -7,6 +7,7 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/agntcy/oasf/schema/go/oasf"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/xeipuuv/gojsonschema"
@@ -14,16 +15,6 @@ import (
 
 const schemaDir = ".."
 
-type SchemaFile struct {
-	Path string
-	Data []byte
-}
-
-type SchemaCache struct {
-	Files []SchemaFile
-	Dirs  []string
-}
-
 type entityTypeData struct {
 	names      map[string][]string
 	extends    []struct {
@@ -32,26 +23,14 @@ type entityTypeData struct {
 	}
 }
 
-var cache SchemaCache
+var cache *oasf.SchemaCache
 var warnings []string
 
 var _ = BeforeSuite(func() {
-	var files []SchemaFile
-	var dirs []string
-	err := filepath.WalkDir(schemaDir, func(path string, d os.DirEntry, err error) error {
-		Expect(err).NotTo(HaveOccurred())
-		if d.IsDir() {
-			dirs = append(dirs, path)
-			return nil
-		}
-		data, err := os.ReadFile(path)
-		Expect(err).NotTo(HaveOccurred())
-		files = append(files, SchemaFile{Path: path, Data: data})
-		return nil
-	})
+	var err error
+	cache, err = oasf.LoadCache(schemaDir)
 	Expect(err).NotTo(HaveOccurred())
-	fmt.Printf("Loaded %d files in %d directories from %s\n", len(files), len(dirs), schemaDir)
-	cache = SchemaCache{Files: files, Dirs: dirs}
+	fmt.Printf("Loaded %d files in %d directories from %s\n", len(cache.Files), len(cache.Dirs), schemaDir)
 
 	// JSON validation gating
 	var errors []string
@@ -83,13 +62,7 @@ var _ = Describe("Metaschema validation", func() {
 		}
 
 		for _, target := range files {
-			var found *SchemaFile
-			for _, f := range cache.Files {
-				if f.Path == target.File {
-					found = &f
-					break
-				}
-			}
+			found := cache.File(target.File)
 			Expect(found).NotTo(BeNil(), "File %s not found in cache", target.File)
 
 			if err := ValidateDataAgainstSchema(found.Data, target.Schema, target.File); err != nil {
@@ -116,7 +89,7 @@ var _ = Describe("Metaschema validation", func() {
 				continue
 			}
 
-			var filesInDir []SchemaFile
+			var filesInDir []oasf.SchemaFile
 			for _, file := range cache.Files {
 				if strings.HasPrefix(file.Path, target.Dir+string(os.PathSeparator)) && filepath.Ext(file.Path) == ".json" {
 					filesInDir = append(filesInDir, file)
@@ -247,109 +220,23 @@ var _ = Describe("JSON content checks", func() {
 			Fail("Errors found:\n" + strings.Join(errors, "\n"))
 		}
 	})
+
+	It("should have unique uids among siblings, computed class ids and module categories", func() {
+		schema, err := oasf.LoadFromCache(cache)
+		Expect(err).NotTo(HaveOccurred())
+		if errors := schema.CheckUIDs(); len(errors) > 0 {
+			Fail("Errors found:\n" + strings.Join(errors, "\n"))
+		}
+	})
 })
 
 var _ = Describe("Attribute dictionary consistency", func() {
-	// Separate describe for skill inheritance cycle detection using Union-Find.
-	// This complements other integrity checks by providing an undirected cycle guard.
-	var _ = Describe("Skill inheritance cycles", func() {
-		It("should not have cycles in skills inheritance (union-find approximation)", func() {
-			skillsDir := filepath.Join(schemaDir, "skills")
-			// Collect (name, extends) pairs.
-			type edge struct{ A, B, File string }
-			var edges []edge
-			names := map[string]string{} // name -> file path
-
-			for _, file := range cache.Files {
-				if !strings.HasPrefix(file.Path, skillsDir+string(os.PathSeparator)) || filepath.Ext(file.Path) != ".json" {
-					continue
-				}
-				var js map[string]interface{}
-				if err := json.Unmarshal(file.Data, &js); err != nil {
-					continue
-				}
-				name, _ := js["name"].(string)
-				if name == "" {
-					continue
-				}
-				names[name] = file.Path
-				extVal, ok := js["extends"]
-				if !ok {
-					continue
-				}
-				switch v := extVal.(type) {
-				case string:
-					if v == "" {
-						break
-					}
-					edges = append(edges, edge{A: name, B: v, File: file.Path})
-				case []interface{}:
-					for _, item := range v {
-						if s, ok := item.(string); ok && s != "" {
-							edges = append(edges, edge{A: name, B: s, File: file.Path})
-						}
-					}
-				}
-			}
-
-			if len(edges) == 0 {
-				return
-			} // nothing to validate
-
-			// Union-Find (Disjoint Set) structure.
-			parent := map[string]string{}
-			rank := map[string]int{}
-			var find func(string) string
-			find = func(x string) string {
-				px, ok := parent[x]
-				if !ok {
-					parent[x] = x
-					rank[x] = 0
-					return x
-				}
-				if px != x {
-					parent[x] = find(px)
-				}
-				return parent[x]
-			}
-			union := func(a, b string) bool { // returns true if merged; false if cycle detected
-				ra := find(a)
-				rb := find(b)
-				if ra == rb {
-					return false
-				}
-				if rank[ra] < rank[rb] {
-					parent[ra] = rb
-				} else if rank[ra] > rank[rb] {
-					parent[rb] = ra
-				} else {
-					parent[rb] = ra
-					rank[ra]++
-				}
-				return true
-			}
-
-			var cycles []string
-			for _, e := range edges {
-				// Skip edges to base_skill; treat as roots not part of cycle detection.
-				if e.B == "base_skill" {
-					continue
-				}
-				// Self-extension (excluding base_skill) counts as cycle immediately.
-				if e.A == e.B {
-					rel, _ := filepath.Rel(schemaDir, e.File)
-					cycles = append(cycles, fmt.Sprintf("Self-cycle: %s extends itself (%s)", e.A, rel))
-					continue
-				}
-				if !union(e.A, e.B) {
-					// Report cycle edge with file context (child file path).
-					rel, _ := filepath.Rel(schemaDir, e.File)
-					cycles = append(cycles, fmt.Sprintf("Cycle edge detected: %s -- %s (from file %s)", e.A, e.B, rel))
-				}
-			}
-
-			if len(cycles) > 0 {
-				Fail("Skill inheritance cycle(s) detected via union-find:\n" + strings.Join(cycles, "\n"))
+	// Separate describe for inheritance cycle detection using a depth-first search
+	// over the extends graph of every entity type.
+	var _ = Describe("Inheritance cycles", func() {
+		It("should not have cycles in the inheritance of any entity type", func() {
+			if cycles := cache.CheckCycles(); len(cycles) > 0 {
+				Fail("Inheritance cycle(s) detected:\n" + strings.Join(cycles, "\n"))
 			}
 		})
 	})